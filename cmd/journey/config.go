@@ -0,0 +1,78 @@
+package main
+
+import (
+	"journey/internal/config"
+	"journey/internal/mailer/mailpit"
+
+	"go.uber.org/zap"
+)
+
+// reload re-reads the environment on SIGHUP and applies the settings that can
+// change at runtime. Anything that needs a restart is reported and skipped.
+func reload(cfg *config.Config, level zap.AtomicLevel, mailer mailpit.Mailpit, logger *zap.Logger) {
+	next, err := config.Load()
+	if err != nil {
+		logger.Error("failed to reload config", zap.Error(err))
+		return
+	}
+
+	if next.LogLevel != cfg.LogLevel {
+		level.SetLevel(next.LogLevel)
+		logger.Info(
+			"config reloaded",
+			zap.String("setting", "log_level"),
+			zap.Stringer("old", cfg.LogLevel),
+			zap.Stringer("new", next.LogLevel),
+		)
+		cfg.LogLevel = next.LogLevel
+	}
+
+	if next.Mail.Settings != cfg.Mail.Settings {
+		mailer.SetSettings(next.Mail.Settings)
+		logger.Info(
+			"config reloaded",
+			zap.String("setting", "mail"),
+			zap.Any("old", cfg.Mail.Settings),
+			zap.Any("new", next.Mail.Settings),
+		)
+		cfg.Mail.Settings = next.Mail.Settings
+	}
+
+	// Listed in name order, so the warnings of a reload always come out the
+	// same way.
+	restartOnly := []struct {
+		setting string
+		changed bool
+	}{
+		{"activity_lock", next.API.LockConfirmedActivities != cfg.API.LockConfirmedActivities},
+		{"addr", next.Server.Addr != cfg.Server.Addr},
+		{"admin_token", next.Auth.AdminToken != cfg.Auth.AdminToken},
+		{"avatars", next.API.Avatars != cfg.API.Avatars},
+		{"cors", !next.Server.CORS.Equal(cfg.Server.CORS)},
+		{"database_url", next.Database.URL != cfg.Database.URL || next.Database.SimpleProtocol != cfg.Database.SimpleProtocol},
+		{"db_read_retry", next.Database.ReadRetry != cfg.Database.ReadRetry},
+		{"db_startup_timeout", next.Database.StartupTimeout != cfg.Database.StartupTimeout},
+		{"default_timezone", next.API.DefaultTimezone != cfg.API.DefaultTimezone},
+		{"dev", next.Server.Dev != cfg.Server.Dev},
+		{"email_domain_blocklist", next.Mail.DomainBlocklist != cfg.Mail.DomainBlocklist},
+		{"env", next.Env != cfg.Env},
+		{"holidays_file", next.API.HolidaysFile != cfg.API.HolidaysFile},
+		{"invite_ttl", next.API.InviteTTL != cfg.API.InviteTTL},
+		{"mail_breaker", next.Mail.BreakerThreshold != cfg.Mail.BreakerThreshold || next.Mail.BreakerCooldown != cfg.Mail.BreakerCooldown || next.Mail.ProbeInterval != cfg.Mail.ProbeInterval},
+		{"max_plus_ones", next.API.MaxPlusOnes != cfg.API.MaxPlusOnes},
+		{"max_trip_days", next.API.MaxTripDays != cfg.API.MaxTripDays},
+		{"outbox", next.Jobs.OutboxMaxAttempts != cfg.Jobs.OutboxMaxAttempts || next.Jobs.OutboxInterval != cfg.Jobs.OutboxInterval || next.Jobs.EmailWorkers != cfg.Jobs.EmailWorkers || next.Mail.ConfirmDelay != cfg.Mail.ConfirmDelay},
+		{"reminder_interval", next.Jobs.ReminderInterval != cfg.Jobs.ReminderInterval},
+		{"request_timeout", next.Server.RequestTimeout != cfg.Server.RequestTimeout},
+		{"slow_query", next.Database.SlowQuery != cfg.Database.SlowQuery},
+		{"statement_timeout", next.Database.StatementTimeout != cfg.Database.StatementTimeout},
+		{"tls", next.Server.TLSCert != cfg.Server.TLSCert || next.Server.TLSKey != cfg.Server.TLSKey},
+		{"trip_cache", next.Database.TripCacheTTL != cfg.Database.TripCacheTTL || next.Database.TripCacheSize != cfg.Database.TripCacheSize},
+		{"unsubscribe_key", next.Mail.UnsubscribeKey != cfg.Mail.UnsubscribeKey},
+	}
+	for _, restart := range restartOnly {
+		if restart.changed {
+			logger.Warn("config change ignored, restart required", zap.String("setting", restart.setting))
+		}
+	}
+}
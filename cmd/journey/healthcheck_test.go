@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"journey/internal/config"
+)
+
+func TestRunHealthcheck(t *testing.T) {
+	ready := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/readyz" {
+			http.NotFound(w, r)
+		}
+	})
+	unavailable := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		tls     bool
+		// cert and key only need to be set for the probe to use TLS.
+		cert, key string
+		wantErr   bool
+	}{
+		{"ready", ready, false, "", "", false},
+		{"not ready", unavailable, false, "", "", true},
+		{"ready over tls", ready, true, "cert.pem", "key.pem", false},
+		{"tls server probed over http", ready, true, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewUnstartedServer(tt.handler)
+			if tt.tls {
+				server.StartTLS()
+			} else {
+				server.Start()
+			}
+			defer server.Close()
+
+			var cfg config.Config
+			cfg.Server.Addr = server.Listener.Addr().String()
+			cfg.Server.TLSCert, cfg.Server.TLSKey = tt.cert, tt.key
+
+			err := runHealthcheck(context.Background(), app{cfg: cfg}, []string{"-timeout", "2s"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runHealthcheck: %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,15 +3,28 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
+	"flag"
 	"fmt"
 	"journey/internal/api"
 	"journey/internal/api/spec"
+	"journey/internal/buildinfo"
+	"journey/internal/config"
+	"journey/internal/jobs"
+	"journey/internal/mailer/breaker"
 	"journey/internal/mailer/mailpit"
+	"journey/internal/mailer/outbox"
+	"journey/internal/pgstore"
+	"journey/internal/reminders"
+	"journey/internal/unsubscribe"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Time zone names are checked against the embedded database, so they
+	// validate the same on images without one.
+	_ "time/tzdata"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -20,56 +33,226 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// app bundles what every subcommand shares.
+type app struct {
+	cfg    config.Config
+	logger *zap.Logger
+	level  zap.AtomicLevel
+}
+
+type command func(ctx context.Context, app app, args []string) error
+
+var commands = map[string]command{
+	"serve":       runServe,
+	"migrate":     runMigrate,
+	"seed":        runSeed,
+	"healthcheck": runHealthcheck,
+}
+
 func main() {
 	ctx := context.Background()
-	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGKILL)
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	if err := run(ctx); err != nil {
+	if err := run(ctx, os.Args[1:]); err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
 	fmt.Println("goodbye :)")
 }
 
-func run(ctx context.Context) error {
+// run dispatches to the subcommand named by the first argument, defaulting to
+// serve so the bare binary keeps working as before.
+func run(ctx context.Context, args []string) error {
+	name := "serve"
+	if len(args) > 0 && args[0] != "" && args[0][0] != '-' {
+		name, args = args[0], args[1:]
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %q, expected one of: serve, migrate, seed, healthcheck", name)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	level := zap.NewAtomicLevelAt(cfg.LogLevel)
+	logger, err := newLogger(level)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = logger.Sync() }()
+	logger.Debug("config loaded", zap.Any("config", cfg))
+
+	return cmd(ctx, app{cfg, logger, level}, args)
+}
+
+func newLogger(level zap.AtomicLevel) (*zap.Logger, error) {
 	cfg := zap.NewDevelopmentConfig()
+	cfg.Level = level
 	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
 
 	logger, err := cfg.Build()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	logger = logger.Named("journey_app")
-	defer func() { _ = logger.Sync() }()
+	return logger.Named("journey_app"), nil
+}
+
+func connect(ctx context.Context, cfg config.Database, logger *zap.Logger) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.URL.Value())
+	if err != nil {
+		return nil, err
+	}
+	pgstore.WithStatementTimeout(poolCfg, cfg.StatementTimeout)
+	if cfg.SimpleProtocol {
+		pgstore.WithSimpleProtocol(poolCfg)
+	}
+	poolCfg.ConnConfig.Tracer = pgstore.NewQueryTracer(logger, cfg.SlowQuery)
 
-	pool, err := pgxpool.New(ctx, fmt.Sprint(
-		"user=postgres password=pgpassword host=localhost port=5432 dbname=journey",
-	),
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ping(ctx, pool, cfg.StartupTimeout, logger); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// ping waits for the database, retrying with backoff for up to timeout so
+// the app can start alongside it, as in docker compose.
+func ping(ctx context.Context, pool *pgxpool.Pool, timeout time.Duration, logger *zap.Logger) error {
+	const maxBackoff = 5 * time.Second
+
+	deadline := time.Now().Add(timeout)
+	backoff := 250 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		err := pool.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 || ctx.Err() != nil {
+			return fmt.Errorf("database unreachable after %d attempts: %w", attempt, err)
+		}
+
+		wait := min(backoff, remaining)
+		logger.Warn("database not ready, retrying", zap.Int("attempt", attempt), zap.Duration("retry_in", wait), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// newJobRunner registers the background jobs of the server.
+func newJobRunner(cfg config.Config, logger *zap.Logger, pool *pgxpool.Pool, mailer mailpit.Mailpit, mailBreaker *breaker.Breaker) *jobs.Runner {
+	runner := jobs.NewRunner(logger)
+
+	worker := outbox.NewWorker(pgstore.New(pool), mailBreaker, logger, cfg.Jobs.OutboxMaxAttempts, cfg.Mail.ConfirmDelay, cfg.Jobs.EmailWorkers)
+	runner.Register(jobs.Job{Name: "outbox", Interval: cfg.Jobs.OutboxInterval, Run: worker.Poll})
+
+	scheduler := reminders.NewScheduler(pgstore.New(pool), logger)
+	runner.Register(jobs.Job{Name: "activity_reminders", Interval: cfg.Jobs.ReminderInterval, Run: scheduler.QueueReminders})
+	runner.Register(jobs.Job{Name: "rsvp_deadlines", Interval: cfg.Jobs.ReminderInterval, Run: scheduler.CloseRSVPs})
+
+	if cfg.Mail.ProbeInterval > 0 {
+		runner.Register(jobs.Job{Name: "mail_probe", Interval: cfg.Mail.ProbeInterval, Run: func(ctx context.Context) error {
+			mailBreaker.Probe(ctx, mailer.Probe, cfg.Mail.ProbeInterval)
+			return nil
+		}})
+	}
+
+	return runner
+}
+
+func runServe(ctx context.Context, app app, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	runJob := fs.String("run-job", "", "run the named background job once and exit, without serving")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, logger := app.cfg, app.logger
+	logger.Info(
+		"starting journey",
+		zap.String("version", buildinfo.Version),
+		zap.String("commit", buildinfo.Commit),
+		zap.String("build_date", buildinfo.Date),
 	)
 
+	useTLS := cfg.Server.TLSEnabled()
+	logger.Info("tls termination", zap.Bool("enabled", useTLS))
+
+	pool, err := connect(ctx, cfg.Database, logger)
 	if err != nil {
 		return err
 	}
 	defer pool.Close()
 
-	if err := pool.Ping(ctx); err != nil {
-		return err
+	trips := pgstore.NewTripCache(cfg.Database.TripCacheTTL, cfg.Database.TripCacheSize)
+	unsubscribes := unsubscribe.NewSigner(cfg.Mail.UnsubscribeKey.Value())
+	mailer := mailpit.NewMailpit(pool, cfg.Database.ReadRetry, logger, cfg.Mail.Settings, trips, unsubscribes)
+	mailBreaker := breaker.New(mailer, logger, cfg.Mail.BreakerThreshold, cfg.Mail.BreakerCooldown)
+
+	runner := newJobRunner(cfg, logger, pool, mailer, mailBreaker)
+	if *runJob != "" {
+		return runner.RunOnce(ctx, *runJob)
 	}
 
-	si := api.NewAPI(pool, logger, mailpit.NewMailpit(pool))
+	var blocklist api.DomainBlocklist
+	if cfg.Mail.DomainBlocklist != "" {
+		if blocklist, err = api.LoadDomainBlocklist(cfg.Mail.DomainBlocklist); err != nil {
+			return err
+		}
+		logger.Info("email domain blocklist loaded", zap.Int("domains", len(blocklist)))
+	}
+	var holidays api.Holidays
+	if cfg.API.HolidaysFile != "" {
+		if holidays, err = api.LoadHolidays(cfg.API.HolidaysFile); err != nil {
+			return err
+		}
+		logger.Info("holidays loaded", zap.Int("places", len(holidays)))
+	}
+
+	store := pgstore.NewStore(pool, cfg.Database.ReadRetry, trips)
+	si := api.NewAPI(store, logger, mailBreaker, blocklist, holidays, unsubscribes, cfg.API.Settings)
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer)
-	r.Mount("/", spec.Handler(&si))
+	// Event streams stay open for as long as the client listens.
+	events := api.TimeoutBudget{Suffix: "/events"}
+	r.Use(middleware.RequestID, api.DebugErrors(!cfg.Production()), api.APIContext(logger, r, cfg.Server.RequestTimeout, events), api.CORS(cfg.Server.CORS, logger), api.AdminOnly(cfg.Auth.AdminToken.Value()))
+	// The counters and the command line are for operators only, AdminOnly
+	// guards everything under /admin.
+	r.Handle("/admin/debug/vars", expvar.Handler())
+	if !cfg.Production() {
+		logger.Warn("error details enabled for ?debug=true requests", zap.String("env", cfg.Env))
+	}
+	if cfg.Server.Dev {
+		logger.Warn("development routes enabled")
+		r.Get("/dev/emails/{template}", mailer.PreviewHandler())
+	}
+	r.Mount("/", spec.Handler(&si, spec.WithErrorHandler(api.ParamError)))
 
 	srv := &http.Server{
-		Addr:         ":3000",
+		Addr:         cfg.Server.Addr,
 		Handler:      r,
 		IdleTimeout:  time.Minute,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 5 * time.Second,
 	}
+	srv.RegisterOnShutdown(si.CloseEvents)
 
 	defer func() {
 		const timeout = 30 * time.Second
@@ -81,22 +264,48 @@ func run(ctx context.Context) error {
 		}
 	}()
 
+	logger.Info("starting background jobs", zap.Strings("jobs", runner.Names()), zap.Int("email_workers", cfg.Jobs.EmailWorkers))
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	jobsDone := make(chan struct{})
+	go func() {
+		defer close(jobsDone)
+		runner.Run(jobsCtx)
+	}()
+	// The runs in progress finish before the pool closes.
+	defer func() {
+		stopJobs()
+		<-jobsDone
+	}()
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
 	errChan := make(chan error, 1)
 
 	go func() {
-		if err := srv.ListenAndServe(); err != nil {
+		var err error
+		if useTLS {
+			err = srv.ListenAndServeTLS(cfg.Server.TLSCert, cfg.Server.TLSKey)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil {
 			errChan <- err
 		}
 	}()
 
-	select {
-	case <-ctx.Done():
-		return nil
-	case err := <-errChan:
-		if err != nil && !errors.Is(err, http.ErrServerClosed) {
-			return err
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hup:
+			reload(&cfg, app.level, mailer, logger)
+		case err := <-errChan:
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
 		}
 	}
-
-	return nil
 }
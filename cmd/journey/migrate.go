@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"journey/internal/pgstore"
+
+	"go.uber.org/zap"
+)
+
+func runMigrate(ctx context.Context, app app, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Migrations may legitimately rewrite whole tables, so they run without
+	// the statement timeout meant for request traffic.
+	db := app.cfg.Database
+	db.StatementTimeout = 0
+
+	pool, err := connect(ctx, db, app.logger)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	from, to, err := pgstore.Migrate(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	app.logger.Info("migrations applied", zap.Int32("from_version", from), zap.Int32("to_version", to))
+	return nil
+}
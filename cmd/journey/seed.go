@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"time"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// runSeed inserts a sample trip, with an activity and a link, for local
+// development.
+func runSeed(ctx context.Context, app app, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	destination := fs.String("destination", "Florianópolis", "destination of the sample trip")
+	ownerName := fs.String("owner-name", "Journey Owner", "name of the sample trip owner")
+	ownerEmail := fs.String("owner-email", "owner@journey.local", "e-mail of the sample trip owner")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pool, err := connect(ctx, app.cfg.Database, app.logger)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	startsAt := time.Now().AddDate(0, 0, 7).Truncate(24 * time.Hour)
+	store := pgstore.New(pool)
+
+	tripID, err := store.CreateTrip(ctx, pool, spec.CreateTripRequest{
+		Destination:    *destination,
+		OwnerName:      *ownerName,
+		OwnerEmail:     openapi_types.Email(*ownerEmail),
+		StartsAt:       startsAt,
+		EndsAt:         startsAt.AddDate(0, 0, 5),
+		EmailsToInvite: []openapi_types.Email{"guest@journey.local"},
+	}, app.cfg.API.InviteTTL)
+	if err != nil {
+		return err
+	}
+
+	if _, err := store.CreateActivity(ctx, pgstore.CreateActivityParams{
+		ID:       pgstore.NewID(),
+		TripID:   tripID,
+		Title:    "Check-in",
+		OccursAt: pgtype.Timestamptz{Valid: true, Time: startsAt.Add(14 * time.Hour)},
+	}); err != nil {
+		return fmt.Errorf("failed to seed activity: %w", err)
+	}
+
+	if _, err := store.CreateTripLink(ctx, pgstore.CreateTripLinkParams{
+		ID:     pgstore.NewID(),
+		TripID: tripID,
+		Title:  "Reserva do hotel",
+		Url:    "https://example.com/booking",
+	}); err != nil {
+		return fmt.Errorf("failed to seed link: %w", err)
+	}
+
+	app.logger.Info("seeded sample trip", zap.String("trip_id", tripID.String()))
+	return nil
+}
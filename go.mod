@@ -12,6 +12,8 @@ require (
 	github.com/jackc/pgx/v5 v5.6.0
 	github.com/wneessen/go-mail v0.4.2
 	go.uber.org/zap v1.27.0
+	golang.org/x/sync v0.7.0
+	golang.org/x/text v0.16.0
 )
 
 require (
@@ -35,8 +37,6 @@ require (
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/crypto v0.25.0 // indirect
 	golang.org/x/net v0.21.0 // indirect
-	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
-	golang.org/x/text v0.16.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
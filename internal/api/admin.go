@@ -0,0 +1,49 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// AdminOnly guards the /admin routes with a static bearer token. Without a
+// token configured the routes behave as if they didn't exist. Routes meant for
+// the trip owner are guarded the same way until owners get tokens of their own.
+func AdminOnly(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/admin/") && !ownerOnly(r.Method, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if token == "" {
+				writeError(w, http.StatusNotFound, codeNotFound, "not found")
+				return
+			}
+
+			given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				writeError(w, http.StatusUnauthorized, codeUnauthorized, "unauthorized")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ownerOnly reports whether the request is for a route only the trip owner
+// may call.
+func ownerOnly(method, path string) bool {
+	switch {
+	case strings.HasPrefix(path, "/participants/"):
+		return strings.HasSuffix(path, "/extend") ||
+			method == http.MethodPatch && strings.HasSuffix(path, "/email")
+	case strings.HasPrefix(path, "/trips/"):
+		return strings.HasSuffix(path, "/resend-invite") ||
+			method == http.MethodDelete && strings.Contains(path, "/participants/") ||
+			method == http.MethodPatch && strings.HasSuffix(path, "/owner-email")
+	}
+	return false
+}
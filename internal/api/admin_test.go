@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOwnerOnly(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodPatch, "/participants/p1/extend", true},
+		{http.MethodPatch, "/participants/p1/email", true},
+		{http.MethodPatch, "/participants/p1/confirm", false},
+		{http.MethodGet, "/participants/p1/status", false},
+		{http.MethodGet, "/participants/activities", false},
+		{http.MethodPost, "/trips/t1/participants/p1/resend-invite", true},
+		{http.MethodDelete, "/trips/t1/participants/p1", true},
+		{http.MethodGet, "/trips/t1/participants/stats", false},
+		{http.MethodGet, "/trips/t1/participants", false},
+		{http.MethodPatch, "/trips/t1/owner-email", true},
+		{http.MethodGet, "/trips/t1/owner-email/verify", false},
+		{http.MethodDelete, "/trips/t1/activities/a1", false},
+		{http.MethodGet, "/trips/t1", false},
+		{http.MethodPost, "/trips", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			if got := ownerOnly(tt.method, tt.path); got != tt.want {
+				t.Errorf("ownerOnly = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdminOnly(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		path   string
+		auth   string
+		status int
+	}{
+		{"open route", "secret", http.MethodGet, "/trips/t1", "", http.StatusNoContent},
+		{"open route without token", "", http.MethodGet, "/trips/t1", "", http.StatusNoContent},
+		{"admin without token configured", "", http.MethodGet, "/admin/emails", "Bearer secret", http.StatusNotFound},
+		{"owner route without token configured", "", http.MethodPatch, "/trips/t1/owner-email", "", http.StatusNotFound},
+		{"missing", "secret", http.MethodGet, "/admin/emails", "", http.StatusUnauthorized},
+		{"not bearer", "secret", http.MethodGet, "/admin/emails", "Basic secret", http.StatusUnauthorized},
+		{"wrong", "secret", http.MethodGet, "/admin/emails", "Bearer secre", http.StatusUnauthorized},
+		{"admin", "secret", http.MethodGet, "/admin/emails", "Bearer secret", http.StatusNoContent},
+		{"debug vars unauthorized", "secret", http.MethodGet, "/admin/debug/vars", "", http.StatusUnauthorized},
+		{"debug vars without token configured", "", http.MethodGet, "/admin/debug/vars", "", http.StatusNotFound},
+		{"owner route", "secret", http.MethodDelete, "/trips/t1/participants/p1", "Bearer secret", http.StatusNoContent},
+		{"owner route unauthorized", "secret", http.MethodDelete, "/trips/t1/participants/p1", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			AdminOnly(tt.token)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Errorf("got %d %s, want %d", rec.Code, rec.Body, tt.status)
+			}
+		})
+	}
+}
@@ -4,42 +4,416 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/go-playground/validator/v10"
 	"github.com/jackc/pgx/v5"
+	"io"
 	"journey/internal/api/spec"
+	"journey/internal/buildinfo"
 	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+	"math"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgtype"
 
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 type mailer interface {
-	SendConfirmTripEmailToTripOwner(uuid.UUID) error
+	SendConfirmTripEmailToTripOwner(context.Context, uuid.UUID) error
+	SendTestEmail(context.Context, string) error
+}
+
+// mailHealth is implemented by mailers that can tell when delivery is
+// failing, like the circuit breaker with its server probe.
+type mailHealth interface {
+	Healthy() bool
 }
 
 type store interface {
-	CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error)
+	Ping(ctx context.Context) error
+	HealthCheck(ctx context.Context) error
+	CreateTrip(ctx context.Context, params spec.CreateTripRequest, inviteTTL time.Duration) (uuid.UUID, error)
 	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
-	ConfirmParticipant(ctx context.Context, participantID uuid.UUID) error
+	ExtendParticipantInvite(ctx context.Context, arg pgstore.ExtendParticipantInviteParams) error
+	ChangeParticipantEmail(ctx context.Context, tripID uuid.UUID, arg pgstore.UpdateParticipantEmailParams, invite bool) (bool, error)
+	ConfirmParticipant(ctx context.Context, arg pgstore.ConfirmParticipantParams) (int64, error)
+	RemoveParticipant(ctx context.Context, arg pgstore.RemoveParticipantParams) (int64, error)
 	GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error)
-	GetTripActivities(ctx context.Context, id uuid.UUID) ([]pgstore.Activity, error)
+	GetTripsByIDs(ctx context.Context, ids []uuid.UUID) ([]pgstore.Trip, error)
+	GetTripIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	ConfirmTrip(ctx context.Context, tripID uuid.UUID) (bool, error)
+	RequestOwnerEmailChange(ctx context.Context, trip pgstore.Trip, email string, ttl time.Duration) error
+	VerifyOwnerEmailChange(ctx context.Context, arg pgstore.VerifyOwnerEmailChangeParams) (int64, error)
+	PublishTrip(ctx context.Context, tripID uuid.UUID) (bool, error)
+	UpdateTrip(ctx context.Context, arg pgstore.UpdateTripIfVersionParams, notify bool) (int32, error)
+	GetTripActivities(ctx context.Context, arg pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error)
+	GetActivitiesForTrips(ctx context.Context, tripIDs []uuid.UUID) ([]pgstore.Activity, error)
+	GetTripActivitiesBetween(ctx context.Context, arg pgstore.GetTripActivitiesBetweenParams) ([]pgstore.Activity, error)
+	GetParticipantActivities(ctx context.Context, arg pgstore.GetParticipantActivitiesParams) ([]pgstore.GetParticipantActivitiesRow, error)
+	GetDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	DeleteDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error)
+	GetTripActivityStats(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripActivityStatsRow, error)
+	GetTripActivityCountsPerDay(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityCountsPerDayRow, error)
+	GetTripParticipants(ctx context.Context, arg pgstore.GetTripParticipantsParams) ([]pgstore.Participant, error)
+	GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripParticipantStatsRow, error)
+	GetParticipantsForTrips(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]pgstore.Participant, error)
+	GetOverlappingTrips(ctx context.Context, arg pgstore.GetOverlappingTripsParams) ([]pgstore.Trip, error)
+	CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error)
+	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
+	GetTripLink(ctx context.Context, arg pgstore.GetTripLinkParams) (pgstore.Link, error)
+	CountTripLinks(ctx context.Context, tripID uuid.UUID) (int64, error)
+	UpdateTripLink(ctx context.Context, arg pgstore.UpdateTripLinkParams) (int64, error)
+	CreateExpense(ctx context.Context, arg pgstore.CreateExpenseParams) (int64, error)
+	GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]pgstore.Expense, error)
+	GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseTotalsRow, error)
+	DeleteExpense(ctx context.Context, arg pgstore.DeleteExpenseParams) (int64, error)
+	CreateChecklistItem(ctx context.Context, arg pgstore.CreateChecklistItemParams) error
+	GetTripChecklist(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChecklistItem, error)
+	AssignChecklistItem(ctx context.Context, arg pgstore.AssignChecklistItemParams) (int64, error)
+	SetChecklistItemDone(ctx context.Context, arg pgstore.SetChecklistItemDoneParams) (int64, error)
+	DeleteChecklistItem(ctx context.Context, arg pgstore.DeleteChecklistItemParams) (int64, error)
+	GetTripDateOptionTallies(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripDateOptionTalliesRow, error)
+	VoteTripDateOption(ctx context.Context, arg pgstore.VoteTripDateOptionParams) (int64, error)
+	SelectTripDateOption(ctx context.Context, tripID, optionID uuid.UUID) (bool, error)
+	InviteParticipantToTrip(ctx context.Context, arg pgstore.InviteParticipantToTripParams) (uuid.UUID, error)
+	EnqueueParticipantEmail(ctx context.Context, arg pgstore.EnqueueParticipantEmailParams) (uuid.UUID, error)
+	CountRecentParticipantEmails(ctx context.Context, arg pgstore.CountRecentParticipantEmailsParams) (int64, error)
+	GetActivity(ctx context.Context, id uuid.UUID) (pgstore.Activity, error)
+	CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error)
+	CreateActivities(ctx context.Context, activities []pgstore.CreateActivityParams) ([]uuid.UUID, error)
+	DeleteActivities(ctx context.Context, arg pgstore.DeleteActivitiesParams) ([]uuid.UUID, error)
+	SetActivityPinned(ctx context.Context, arg pgstore.SetActivityPinnedParams) ([]uuid.UUID, error)
+	GetDeadLetterEmails(ctx context.Context) ([]pgstore.EmailOutbox, error)
+	RequeueEmail(ctx context.Context, id uuid.UUID) (int64, error)
+	RecordTestEmail(ctx context.Context, arg pgstore.RecordTestEmailParams) (uuid.UUID, error)
+	ListEmailSuppressions(ctx context.Context) ([]pgstore.EmailSuppression, error)
+	GetSuppressedEmails(ctx context.Context, emails []string) ([]string, error)
+	SuppressEmail(ctx context.Context, arg pgstore.SuppressEmailParams) (pgstore.EmailSuppression, error)
+	UnsubscribeEmail(ctx context.Context, arg pgstore.UnsubscribeEmailParams) error
+	DeleteEmailSuppression(ctx context.Context, email string) (int64, error)
+	GetGlobalStats(ctx context.Context) (pgstore.GetGlobalStatsRow, error)
+	ListTrips(ctx context.Context, arg pgstore.ListTripsParams) ([]pgstore.Trip, error)
 }
 
+// ownerEmailChangeTTL is how long the link verifying a new owner e-mail
+// stays valid.
+const ownerEmailChangeTTL = 24 * time.Hour
+
 type ApiServer struct {
 	store     store
 	logger    *zap.Logger
 	validator *validator.Validate
-	pool      *pgxpool.Pool
 	mailer    mailer
+	// maxTripDays caps how long a trip may last.
+	maxTripDays int
+	blocklist   DomainBlocklist
+	holidays    Holidays
+	// inviteTTL is how long an invite can be confirmed, at most until the
+	// trip starts.
+	inviteTTL time.Duration
+	// maxPlusOnes caps the companions a participant can bring.
+	maxPlusOnes int
+	// defaultTimezone is given to the trips created without one.
+	defaultTimezone string
+	avatars         Avatars
+	// lockConfirmed refuses activity changes once the trip is confirmed.
+	lockConfirmed bool
+	events        *tripEvents
+	unsubscribe   unsubscribe.Signer
+}
+
+// Settings are the tunables of the handlers, read from the configuration.
+type Settings struct {
+	// MaxTripDays caps how long a trip may last.
+	MaxTripDays int
+	// InviteTTL is how long invites can be confirmed, capped at the trip
+	// start. Zero keeps them valid forever.
+	InviteTTL time.Duration
+	// MaxPlusOnes caps the companions a participant can bring.
+	MaxPlusOnes int
+	// DefaultTimezone is the IANA time zone of the trips created without
+	// one.
+	DefaultTimezone string
+	// Avatars is the Gravatar default image of participants, empty when
+	// avatars are turned off.
+	Avatars Avatars
+	// LockConfirmedActivities refuses adding, pinning or removing activities
+	// of confirmed trips.
+	LockConfirmedActivities bool
 }
 
-func NewAPI(poll *pgxpool.Pool, logger *zap.Logger, mailer mailer) ApiServer {
+// NewAPI serves the API from store, a *pgstore.Store outside of the tests.
+func NewAPI(store store, logger *zap.Logger, mailer mailer, blocklist DomainBlocklist, holidays Holidays, unsubscribes unsubscribe.Signer, settings Settings) ApiServer {
 	validator := validator.New()
-	return ApiServer{pgstore.New(poll), logger, validator, poll, mailer}
+	return ApiServer{
+		store:           store,
+		logger:          logger,
+		validator:       validator,
+		mailer:          mailer,
+		maxTripDays:     settings.MaxTripDays,
+		blocklist:       blocklist,
+		holidays:        holidays,
+		inviteTTL:       settings.InviteTTL,
+		maxPlusOnes:     settings.MaxPlusOnes,
+		defaultTimezone: settings.DefaultTimezone,
+		avatars:         settings.Avatars,
+		lockConfirmed:   settings.LockConfirmedActivities,
+		events:          newTripEvents(),
+		unsubscribe:     unsubscribes,
+	}
+}
+
+// checkTimezone accepts IANA zone names. Local is refused, it would mean
+// whatever zone the server runs in.
+func checkTimezone(name string) error {
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		return fmt.Errorf("timezone %q is not a known IANA time zone", name)
+	}
+	return nil
+}
+
+// checkActivitiesLocked answers 409 when the trip activities can't change
+// anymore, nil otherwise.
+func (api ApiServer) checkActivitiesLocked(trip pgstore.Trip) *spec.Response {
+	if api.lockConfirmed && trip.IsConfirmed {
+		return respondError(http.StatusConflict, codeTripLocked, "trip is confirmed, its activities can't be changed anymore")
+	}
+	return nil
+}
+
+// checkPlusOnes enforces the configured cap, the validator already rejects
+// negative numbers.
+func (api ApiServer) checkPlusOnes(plusOnes int) error {
+	if plusOnes > api.maxPlusOnes {
+		return fmt.Errorf("plus_ones can't be more than %d", api.maxPlusOnes)
+	}
+	return nil
+}
+
+// mailHealthy reports whether mail is being delivered, mailers that can't
+// tell are assumed to work.
+func (api ApiServer) mailHealthy() bool {
+	mh, ok := api.mailer.(mailHealth)
+	return !ok || mh.Healthy()
+}
+
+// GetReadyz Report whether the API is ready to serve traffic.
+// (GET /readyz)
+func (api ApiServer) GetReadyz(w http.ResponseWriter, r *http.Request) *spec.Response {
+	if err := api.store.Ping(r.Context()); err != nil {
+		api.log(r.Context()).Warn("readiness check failed", zap.Error(err))
+		return spec.GetReadyzJSON503Response(spec.ReadinessResponse{Status: "database unavailable"})
+	}
+
+	// Connecting isn't enough, a database the migrations haven't reached
+	// would fail every request.
+	if err := api.store.HealthCheck(r.Context()); err != nil {
+		api.log(r.Context()).Warn("readiness check failed", zap.Error(err))
+		return spec.GetReadyzJSON503Response(spec.ReadinessResponse{Status: "database not queryable"})
+	}
+
+	resp := spec.ReadinessResponse{Status: "ok"}
+	if _, ok := api.mailer.(mailHealth); ok {
+		resp.Mail = "ok"
+		if !api.mailHealthy() {
+			resp.Mail = "degraded"
+		}
+	}
+
+	return spec.GetReadyzJSON200Response(resp)
+}
+
+// GetVersion Get the version of the running build.
+// (GET /version)
+func (api ApiServer) GetVersion(w http.ResponseWriter, r *http.Request) *spec.Response {
+	return spec.GetVersionJSON200Response(spec.VersionResponse{
+		Version:   buildinfo.Version,
+		Commit:    buildinfo.Commit,
+		BuildDate: buildinfo.Date,
+	})
+}
+
+// GetAdminEmailsDeadLetter List the e-mails that exhausted their retries.
+// (GET /admin/emails/dead-letter)
+func (api ApiServer) GetAdminEmailsDeadLetter(w http.ResponseWriter, r *http.Request) *spec.Response {
+	emails, err := api.store.GetDeadLetterEmails(r.Context())
+	if err != nil {
+		api.log(r.Context()).Error("failed to get dead letter emails", zap.Error(err))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.GetDeadLetterEmailsResponse{Emails: make([]spec.DeadLetterEmail, len(emails))}
+	for i, email := range emails {
+		response.Emails[i] = spec.DeadLetterEmail{
+			ID:        email.ID.String(),
+			Kind:      email.Kind,
+			Attempts:  int(email.Attempts),
+			LastError: email.LastError.String,
+			CreatedAt: naiveUTC(email.CreatedAt),
+			RequestID: email.RequestID.String,
+		}
+		if email.TripID.Valid {
+			response.Emails[i].TripID = uuid.UUID(email.TripID.Bytes).String()
+		}
+	}
+
+	return spec.GetAdminEmailsDeadLetterJSON200Response(response)
+}
+
+// GetAdminStats Get the totals across every trip.
+// (GET /admin/stats)
+func (api ApiServer) GetAdminStats(w http.ResponseWriter, r *http.Request) *spec.Response {
+	stats, err := api.store.GetGlobalStats(r.Context())
+	if err != nil {
+		api.log(r.Context()).Error("failed to get global stats", zap.Error(err))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetAdminStatsJSON200Response(spec.GetGlobalStatsResponse{
+		Trips:            stats.Trips,
+		ConfirmedTrips:   stats.ConfirmedTrips,
+		UnconfirmedTrips: stats.Trips - stats.ConfirmedTrips,
+		Activities:       stats.Activities,
+		Participants:     stats.Participants,
+	})
+}
+
+// GetAdminTrips List every trip, page by page.
+// (GET /admin/trips)
+func (api ApiServer) GetAdminTrips(w http.ResponseWriter, r *http.Request, params spec.GetAdminTripsParams) *spec.Response {
+	limit, _, err := pagination(params.Limit, nil)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	fields, err := parseTripFields(params.Fields)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	after := firstTripCursor
+	if params.Cursor != nil {
+		if after, err = decodeTripCursor(*params.Cursor); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	// One trip more than the page tells whether there is a next one.
+	trips, err := api.store.ListTrips(r.Context(), pgstore.ListTripsParams{
+		AfterStartsAt: after.startsAt,
+		AfterID:       after.id,
+		Limit:         limit + 1,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to list trips", zap.Error(err))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.ListTripsResponse{Trips: make([]spec.GetTripDetailsResponseTripObj, 0, min(len(trips), int(limit)))}
+	if len(trips) > int(limit) {
+		trips = trips[:limit]
+		last := trips[len(trips)-1]
+		next := newTripCursor(last.StartsAt, last.ID).encode()
+		response.NextCursor = &next
+	}
+	for _, trip := range trips {
+		response.Trips = append(response.Trips, tripDetails(trip))
+	}
+
+	if fields != nil {
+		projected, err := fields.projectAll(response.Trips)
+		if err != nil {
+			api.log(r.Context()).Error("failed to project trips", zap.Error(err))
+			return respondError(http.StatusInternalServerError, codeInternal, "something went wrong, try again")
+		}
+		body := map[string]any{"trips": projected}
+		if response.NextCursor != nil {
+			body["next_cursor"] = *response.NextCursor
+		}
+		return jsonOK(body)
+	}
+
+	return spec.GetAdminTripsJSON200Response(response)
+}
+
+// PostAdminEmailsEmailIDRequeue Send a dead letter e-mail again.
+// (POST /admin/emails/{emailId}/requeue)
+func (api ApiServer) PostAdminEmailsEmailIDRequeue(w http.ResponseWriter, r *http.Request, emailID string) *spec.Response {
+	id, err := uuid.Parse(emailID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	requeued, err := api.store.RequeueEmail(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to requeue email", zap.Error(err), zap.String("email_id", emailID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if requeued == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "dead letter email not found")
+	}
+
+	return spec.PostAdminEmailsEmailIDRequeueJSON204Response(nil)
+}
+
+// PostAdminEmailsTest Send a test e-mail through the configured mail server.
+// (POST /admin/emails/test)
+func (api ApiServer) PostAdminEmailsTest(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.TestEmailRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	sendErr := api.mailer.SendTestEmail(r.Context(), string(body.Email))
+	if sendErr != nil {
+		api.log(r.Context()).Warn("test email failed", zap.Error(sendErr), zap.String("email", string(body.Email)))
+	}
+	api.recordTestEmail(r.Context(), string(body.Email), sendErr)
+
+	if sendErr != nil {
+		return spec.PostAdminEmailsTestJSON200Response(spec.TestEmailResponse{Sent: false, Error: sendErr.Error()})
+	}
+	return spec.PostAdminEmailsTestJSON200Response(spec.TestEmailResponse{Sent: true})
+}
+
+// recordTestEmail logs a test send in the outbox, sent or dead lettered with
+// its error, so it can be found and requeued like any other e-mail. The send
+// already happened, a failure to log it is only logged.
+func (api ApiServer) recordTestEmail(ctx context.Context, to string, sendErr error) {
+	payload, err := json.Marshal(pgstore.TestEmailPayload{To: to})
+	if err != nil {
+		api.log(ctx).Error("failed to encode test email payload", zap.Error(err))
+		return
+	}
+
+	arg := pgstore.RecordTestEmailParams{
+		Kind:      pgstore.EmailKindTestEmail,
+		Status:    pgstore.EmailStatusSent,
+		RequestID: pgstore.RequestID(ctx),
+		Payload:   payload,
+	}
+	if sendErr != nil {
+		arg.Status = pgstore.EmailStatusDeadLetter
+		arg.LastError = pgtype.Text{String: sendErr.Error(), Valid: true}
+	}
+
+	if _, err := api.store.RecordTestEmail(ctx, arg); err != nil {
+		api.log(ctx).Error("failed to record test email", zap.Error(err))
+	}
 }
 
 // PatchParticipantsParticipantIDConfirm Confirms a participant on a trip.
@@ -47,195 +421,2209 @@ func NewAPI(poll *pgxpool.Pool, logger *zap.Logger, mailer mailer) ApiServer {
 func (api ApiServer) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
 	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "uuid invalid"})
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	// The body is optional, confirming without one keeps the invite plus ones.
+	var body spec.ConfirmParticipantRequest
+	if err := decodeJSON(r, &body); err != nil && !errors.Is(err, io.EOF) {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	var plusOnes pgtype.Int4
+	if body.PlusOnes != nil {
+		if err := api.checkPlusOnes(*body.PlusOnes); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+		plusOnes = pgtype.Int4{Int32: int32(*body.PlusOnes), Valid: true}
 	}
 
 	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-				Message: "participant not found",
-			})
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "participant not found", err)
 		}
-		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
 	}
 
 	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "participant already confirmed",
-		})
+		return respondError(http.StatusBadRequest, codeAlreadyConfirmed, "participant already confirmed")
 	}
 
-	if err := api.store.ConfirmParticipant(r.Context(), id); err != nil {
-		api.logger.Error("failed to confim participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	// Checked before the invite, the deadline job expires the pending ones
+	// and the participant should learn why.
+	if rsvpClosed(trip) {
+		return respondError(http.StatusGone, codeRSVPClosed, "rsvp deadline passed")
+	}
+
+	if inviteExpired(participant) {
+		return respondError(http.StatusGone, codeInviteExpired, "invite expired")
+	}
+
+	// The update only applies to a pending participant, so when two requests
+	// race past the check above just one of them confirms.
+	confirmed, err := api.store.ConfirmParticipant(r.Context(), pgstore.ConfirmParticipantParams{PlusOnes: plusOnes, ID: id})
+	if err != nil {
+		api.log(r.Context()).Error("failed to confim participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if confirmed == 0 {
+		return respondError(http.StatusBadRequest, codeAlreadyConfirmed, "participant already confirmed")
 	}
 
 	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
 }
 
-// PostTrips Create a new trip
-// (POST /trips)
-func (api ApiServer) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
-	var body spec.CreateTripRequest
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid JSON"})
+// PostParticipantsParticipantIDExtend Extend an expired or expiring invite and send it again.
+// (POST /participants/{participantId}/extend)
+func (api ApiServer) PostParticipantsParticipantIDExtend(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "participant not found", err)
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if participant.IsConfirmed {
+		return respondError(http.StatusBadRequest, codeAlreadyConfirmed, "participant already confirmed")
 	}
 
-	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			api.logger.Error(
-				"failed to send email on PostTrips",
-				zap.Error(err),
-				zap.String("trip_id", tripID.String()),
-			)
-		}
-	}()
+	if rsvpClosed(trip) {
+		return respondError(http.StatusBadRequest, codeRSVPClosed, "rsvp deadline passed")
+	}
 
-	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
-}
+	expiresAt := pgstore.InviteExpiry(trip.StartsAt, api.inviteTTL)
+	if err := api.store.ExtendParticipantInvite(r.Context(), pgstore.ExtendParticipantInviteParams{
+		ExpiresAt: expiresAt,
+		ID:        id,
+	}); err != nil {
+		api.log(r.Context()).Error("failed to extend invite", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
 
-// GetTripsTripID Get a trip details.
-// (GET /trips/{tripId})
-func (api ApiServer) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	if _, err := api.store.EnqueueParticipantEmail(r.Context(), pgstore.EnqueueParticipantEmailParams{
+		TripID:        trip.ID,
+		ParticipantID: pgtype.UUID{Bytes: id, Valid: true},
+		Kind:          pgstore.EmailKindParticipantInvite,
+		RequestID:     pgstore.RequestID(r.Context()),
+	}); err != nil {
+		api.log(r.Context()).Error("failed to enqueue invite email", zap.Error(err), zap.String("participant_id", participantID))
+	}
+
+	response := spec.ExtendInviteResponse{ExpiresAt: naiveUTCOrNil(expiresAt)}
 
-	id, err := uuid.Parse(tripID)
+	return spec.PostParticipantsParticipantIDExtendJSON200Response(response)
+}
+
+// PatchParticipantsParticipantIDEmail Correct a participant e-mail and send the invite again.
+// (PATCH /participants/{participantId}/email)
+func (api ApiServer) PatchParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "uuid invalid"})
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), id)
+	var body spec.ChangeParticipantEmailRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	email := normalizeEmail(string(body.Email))
+	if api.blocklist.Blocked(email) {
+		return respondError(http.StatusBadRequest, codeEmailDomainBlocked, "e-mail domain not allowed")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDJSON400Response(spec.Error{
-				Message: "Trip not found",
-			})
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "participant not found", err)
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("tripID", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
 	}
 
-	responseTrip := spec.GetTripDetailsResponseTripObj{
-		ID:          trip.ID.String(),
-		Destination: trip.Destination,
-		EndsAt:      trip.EndsAt.Time,
-		IsConfirmed: trip.IsConfirmed,
-		StartsAt:    trip.StartsAt.Time,
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if rsvpClosed(trip) {
+		return respondError(http.StatusBadRequest, codeRSVPClosed, "rsvp deadline passed")
+	}
+
+	// Unconfirmed trips send the invite when they get confirmed, like the
+	// other pending participants.
+	changed, err := api.store.ChangeParticipantEmail(r.Context(), trip.ID, pgstore.UpdateParticipantEmailParams{
+		Email:     email,
+		ExpiresAt: pgstore.InviteExpiry(trip.StartsAt, api.inviteTTL),
+		ID:        id,
+	}, trip.IsConfirmed)
+	if err != nil {
+		api.log(r.Context()).Error("failed to change participant e-mail", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if !changed {
+		return respondError(http.StatusConflict, codeAlreadyInvited, "e-mail already invited to this trip")
 	}
 
-	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{Trip: responseTrip})
+	api.log(r.Context()).Info(
+		"participant e-mail changed",
+		zap.String("participant_id", participantID),
+		zap.String("tripID", trip.ID.String()),
+		zap.String("old", redactEmail(participant.Email)),
+		zap.String("new", redactEmail(email)),
+		zap.Bool("was_confirmed", participant.IsConfirmed),
+	)
 
+	return spec.PatchParticipantsParticipantIDEmailJSON204Response(nil)
 }
 
-// PutTripsTripID Update a trip.
-// (PUT /trips/{tripId})
-func (api ApiServer) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// normalizeEmail trims the address and lowercases its domain, the local
+// part is left alone since servers may tell its case apart.
+func normalizeEmail(email string) string {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return email
+	}
+	return email[:at] + strings.ToLower(email[at:])
+}
 
+// redactEmail keeps the first letter and the domain of an address, enough
+// to tell two apart in the logs.
+func redactEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at < 1 {
+		return "***"
+	}
+	_, size := utf8.DecodeRuneInString(email)
+	return email[:size] + "***" + email[at:]
 }
 
-// GetTripsTripIDActivities Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (api ApiServer) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// resendInviteCooldown is how long a participant must wait between invites.
+const resendInviteCooldown = 10 * time.Minute
 
-	id, err := uuid.Parse(tripID)
+// PostTripsTripIDParticipantsParticipantIDResendInvite Send the invite e-mail of a pending participant again.
+// (POST /trips/{tripId}/participants/{participantId}/resend-invite)
+func (api ApiServer) PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "uuid invalid"})
+		return api.existingTripFailure(r.Context(), err)
 	}
 
-	tripActivities, err := api.store.GetTripActivities(r.Context(), id)
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
-				Message: "no trips found",
-			})
-		}
-		api.logger.Error("failed to get trips", zap.Error(err), zap.String("tripID", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+	if err != nil || participant.TripID != trip.ID {
+		return respondError(http.StatusNotFound, codeNotFound, "participant not found")
 	}
 
-	responseActivities := mapActivities(tripActivities)
+	if participant.IsConfirmed {
+		return respondError(http.StatusBadRequest, codeAlreadyConfirmed, "participant already confirmed")
+	}
 
-	response := spec.GetTripActivitiesResponse{
-		Activities: responseActivities,
+	if rsvpClosed(trip) {
+		return respondError(http.StatusBadRequest, codeRSVPClosed, "rsvp deadline passed")
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(response)
-}
+	if inviteExpired(participant) {
+		return respondError(http.StatusBadRequest, codeInviteExpired, "invite expired, extend it instead")
+	}
 
-func mapActivities(activities []pgstore.Activity) []spec.GetTripActivitiesResponseOuterArray {
-	activityMap := make(map[time.Time][]spec.GetTripActivitiesResponseInnerArray)
-	for _, activity := range activities {
-		innerActivity := spec.GetTripActivitiesResponseInnerArray{
-			ID:       activity.ID.String(),
-			OccursAt: activity.OccursAt.Time,
-			Title:    activity.Title,
-		}
-		activityMap[activity.OccursAt.Time] = append(activityMap[activity.OccursAt.Time], innerActivity)
+	recent, err := api.store.CountRecentParticipantEmails(r.Context(), pgstore.CountRecentParticipantEmailsParams{
+		ParticipantID: pgtype.UUID{Bytes: id, Valid: true},
+		Kind:          pgstore.EmailKindParticipantInvite,
+		WindowSeconds: resendInviteCooldown.Seconds(),
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to count recent invites", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+	if recent > 0 {
+		return respondError(http.StatusTooManyRequests, codeRateLimited, fmt.Sprintf("invite already sent, try again in %s", resendInviteCooldown))
 	}
 
-	var outerActivities []spec.GetTripActivitiesResponseOuterArray
-	for date, innerActivities := range activityMap {
-		outerActivity := spec.GetTripActivitiesResponseOuterArray{
-			Activities: innerActivities,
-			Date:       date,
-		}
-		outerActivities = append(outerActivities, outerActivity)
+	// The outbox row, tagged with the request ID, is the record of the resend.
+	if _, err := api.store.EnqueueParticipantEmail(r.Context(), pgstore.EnqueueParticipantEmailParams{
+		TripID:        trip.ID,
+		ParticipantID: pgtype.UUID{Bytes: id, Valid: true},
+		Kind:          pgstore.EmailKindParticipantInvite,
+		RequestID:     pgstore.RequestID(r.Context()),
+	}); err != nil {
+		api.log(r.Context()).Error("failed to enqueue invite email", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
 	}
 
-	return outerActivities
+	return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response(nil)
 }
 
-// PostTripsTripIDActivities Create a trip activity.
-// (POST /trips/{tripId}/activities)
-func (api ApiServer) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// inviteExpired reports whether a pending invite can no longer be confirmed.
+func inviteExpired(participant pgstore.Participant) bool {
+	return !participant.IsConfirmed && participant.ExpiresAt.Valid && time.Now().UTC().After(participant.ExpiresAt.Time)
 }
 
-// GetTripsTripIDConfirm Confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (api ApiServer) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// rsvpClosed reports whether the trip no longer takes confirmations.
+func rsvpClosed(trip pgstore.Trip) bool {
+	return trip.RsvpDeadline.Valid && !time.Now().Before(trip.RsvpDeadline.Time)
 }
 
-// PostTripsTripIDInvites Invite someone to the trip.
-// (POST /trips/{tripId}/invites)
-func (api ApiServer) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// checkRSVPDeadline rejects a deadline that leaves no time to confirm, it must
+// be in the future and before the trip starts when the start is known.
+func checkRSVPDeadline(deadline, startsAt time.Time) error {
+	if !deadline.After(time.Now()) {
+		return errors.New("rsvp_deadline must be in the future")
+	}
+	if !startsAt.IsZero() && !deadline.Before(startsAt) {
+		return errors.New("rsvp_deadline must be before starts_at")
+	}
+	return nil
 }
 
-// GetTripsTripIDLinks Get a trip links.
-// (GET /trips/{tripId}/links)
-func (api ApiServer) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// GetParticipantsParticipantIDStatus Get a participant confirmation state and the trip details, without confirming.
+// (GET /participants/{participantId}/status)
+func (api ApiServer) GetParticipantsParticipantIDStatus(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "participant not found", err)
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("tripID", participant.TripID.String()))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetParticipantsParticipantIDStatusJSON200Response(spec.GetParticipantStatusResponse{
+		ID:          participant.ID.String(),
+		IsConfirmed: participant.IsConfirmed,
+		AvatarURL:   api.avatars.url(participant.Email),
+		Trip: spec.GetParticipantStatusResponseTripObj{
+			ID:          trip.ID.String(),
+			Destination: trip.Destination,
+			OwnerName:   trip.OwnerName,
+			StartsAt:    utc(trip.StartsAt),
+			EndsAt:      utc(trip.EndsAt),
+		},
+	})
 }
 
-// PostTripsTripIDLinks Create a trip link.
-// (POST /trips/{tripId}/links)
-func (api ApiServer) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// maxBatchTrips caps how many trips GET /trips answers at once.
+const maxBatchTrips = 50
+
+// GetTrips Get the details of several trips at once.
+// (GET /trips)
+func (api ApiServer) GetTrips(w http.ResponseWriter, r *http.Request, params spec.GetTripsParams) *spec.Response {
+	raws := strings.Split(params.Ids, ",")
+	if len(raws) > maxBatchTrips {
+		return respondError(http.StatusBadRequest, codeInvalidInput, fmt.Sprintf("ids can list at most %d trips", maxBatchTrips))
+	}
+
+	fields, err := parseTripFields(params.Fields)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	ids := make([]uuid.UUID, 0, len(raws))
+	seen := make(map[uuid.UUID]bool, len(raws))
+	for _, raw := range raws {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid: "+raw)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	trips, err := api.store.GetTripsByIDs(r.Context(), ids)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trips", zap.Error(err), zap.Int("trips", len(ids)))
+		return storeFailure(r.Context(), err)
+	}
+
+	byID := make(map[uuid.UUID]pgstore.Trip, len(trips))
+	for _, trip := range trips {
+		byID[trip.ID] = trip
+	}
+
+	// Trips come back in request order, unknown ones are left out.
+	response := spec.GetTripsResponse{Trips: make([]spec.GetTripDetailsResponseTripObj, 0, len(trips))}
+	for _, id := range ids {
+		if trip, ok := byID[id]; ok {
+			response.Trips = append(response.Trips, tripDetails(trip))
+		}
+	}
+
+	if fields != nil {
+		projected, err := fields.projectAll(response.Trips)
+		if err != nil {
+			api.log(r.Context()).Error("failed to project trips", zap.Error(err))
+			return respondError(http.StatusInternalServerError, codeInternal, "something went wrong, try again")
+		}
+		return jsonOK(map[string]any{"trips": projected})
+	}
+
+	return spec.GetTripsJSON200Response(response)
 }
 
-// GetTripsTripIDParticipants Get a trip participants.
-// (GET /trips/{tripId}/participants)
-func (api ApiServer) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// PostTrips Create a new trip
+// (POST /trips)
+func (api ApiServer) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.CreateTripRequest
+	if err := decodeJSON(r, &body); err != nil {
+		var dateErr *spec.TripDateError
+		if errors.As(err, &dateErr) {
+			return respondError(http.StatusBadRequest, codeInvalidInput, dateErr.Error())
+		}
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	polling := len(body.DateOptions) > 0
+	if polling {
+		if body.Draft || !body.StartsAt.IsZero() || !body.EndsAt.IsZero() {
+			return respondError(http.StatusBadRequest, codeInvalidInput, "date_options can't be combined with draft, starts_at or ends_at")
+		}
+		for _, option := range body.DateOptions {
+			if option.EndsAt.Before(option.StartsAt) {
+				return respondError(http.StatusBadRequest, codeInvalidInput, "date option ends_at must not be before starts_at")
+			}
+			if err := api.checkTripDuration(option.StartsAt, option.EndsAt); err != nil {
+				return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+			}
+		}
+	}
+
+	// Drafts may leave the dates out, but once both are there they are held
+	// to the same limit, so publishing can't be the first place it fails.
+	if !polling && (!body.Draft || (!body.StartsAt.IsZero() && !body.EndsAt.IsZero())) {
+		if err := api.checkTripDuration(body.StartsAt, body.EndsAt); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	if !body.RsvpDeadline.IsZero() {
+		if err := checkRSVPDeadline(body.RsvpDeadline, body.StartsAt); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	if body.Timezone == "" {
+		body.Timezone = api.defaultTimezone
+	} else if err := checkTimezone(body.Timezone); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	var warnings []string
+	if !body.Draft && !polling {
+		warnings = api.overlappingTripWarnings(r.Context(), body)
+	}
+
+	tripID, err := api.store.CreateTrip(r.Context(), body, api.inviteTTL)
+	if err != nil {
+		if isTimeout(err) {
+			return storeFailure(r.Context(), err)
+		}
+		return respondError(http.StatusInternalServerError, codeInternal, "failed to create trip, try again")
+	}
+
+	response := spec.CreateTripResponse{TripID: tripID.String(), Warnings: warnings}
+	if !body.StartsAt.IsZero() && !body.EndsAt.IsZero() {
+		response.Holidays = api.holidays.During(body.Destination, body.StartsAt, body.EndsAt)
+	}
+	if !body.Draft && !api.mailHealthy() {
+		response.EmailDelayed = true
+	}
+
+	emails := []string{string(body.OwnerEmail)}
+	for _, email := range body.EmailsToInvite {
+		emails = append(emails, string(email))
+	}
+	response.SuppressedEmails = api.suppressedEmails(r.Context(), emails)
+
+	return spec.PostTripsJSON201Response(response)
+}
+
+// normalizeLinkURL accepts only absolute http(s) links and lowercases the
+// scheme and host so the same link isn't stored in several spellings.
+func normalizeLinkURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid url: %w", err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errors.New("url must be an absolute http or https link")
+	}
+	u.Host = strings.ToLower(u.Host)
+
+	return u.String(), nil
+}
+
+// checkTripDuration rejects trips lasting longer than the configured maximum.
+func (api ApiServer) checkTripDuration(startsAt, endsAt time.Time) error {
+	if api.maxTripDays <= 0 {
+		return nil
+	}
+
+	duration := endsAt.Sub(startsAt)
+	if duration <= time.Duration(api.maxTripDays)*24*time.Hour {
+		return nil
+	}
+
+	days := int(math.Ceil(duration.Hours() / 24))
+	return fmt.Errorf("trip lasts %d days, the maximum is %d days", days, api.maxTripDays)
+}
+
+// overlappingTripWarnings lists the owner's published trips sharing dates with
+// the new one. It is only advisory, so a failed lookup is logged and ignored.
+func (api ApiServer) overlappingTripWarnings(ctx context.Context, body spec.CreateTripRequest) []string {
+	trips, err := api.store.GetOverlappingTrips(ctx, pgstore.GetOverlappingTripsParams{
+		OwnerEmail: string(body.OwnerEmail),
+		StartsAt:   pgtype.Timestamptz{Valid: true, Time: body.StartsAt},
+		EndsAt:     pgtype.Timestamptz{Valid: true, Time: body.EndsAt},
+	})
+	if err != nil {
+		api.log(ctx).Warn("failed to check overlapping trips", zap.Error(err), zap.String("owner_email", string(body.OwnerEmail)))
+		return nil
+	}
+
+	warnings := make([]string, 0, len(trips))
+	for _, trip := range trips {
+		warnings = append(warnings, fmt.Sprintf(
+			"dates overlap your trip to %s from %s to %s",
+			trip.Destination,
+			trip.StartsAt.Time.UTC().Format(time.DateOnly),
+			trip.EndsAt.Time.UTC().Format(time.DateOnly),
+		))
+	}
+
+	return warnings
+}
+
+// GetTripsTripID Get a trip details.
+// (GET /trips/{tripId})
+func (api ApiServer) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParams) *spec.Response {
+
+	id, err := api.tripID(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	loc, err := requestLocale(r, params.Locale)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+	w.Header().Add("Vary", "Accept-Language")
+
+	fields, err := parseTripFields(params.Fields)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "Trip not found", err)
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	responseTrip := tripDetails(trip)
+	if loc != nil {
+		responseTrip.StartsAtFormatted = loc.date(responseTrip.StartsAt)
+		responseTrip.EndsAtFormatted = loc.date(responseTrip.EndsAt)
+	}
+
+	if fields != nil {
+		projected, err := fields.project(responseTrip)
+		if err != nil {
+			api.log(r.Context()).Error("failed to project trip", zap.Error(err), zap.String("tripID", tripID))
+			return respondError(http.StatusInternalServerError, codeInternal, "something went wrong, try again")
+		}
+		return withETag(w, r, map[string]any{"trip": projected}, jsonOK)
+	}
+
+	return withETag(w, r, spec.GetTripDetailsResponse{Trip: responseTrip}, spec.GetTripsTripIDJSON200Response)
+
+}
+
+func tripDetails(trip pgstore.Trip) spec.GetTripDetailsResponseTripObj {
+	return spec.GetTripDetailsResponseTripObj{
+		ID:           trip.ID.String(),
+		Destination:  trip.Destination,
+		EndsAt:       utc(trip.EndsAt),
+		IsConfirmed:  trip.IsConfirmed,
+		IsDraft:      trip.IsDraft,
+		IsPolling:    trip.IsPolling,
+		StartsAt:     utc(trip.StartsAt),
+		Slug:         trip.Slug,
+		Version:      trip.Version,
+		RsvpDeadline: utcOrNil(trip.RsvpDeadline),
+		Timezone:     trip.Timezone,
+	}
+}
+
+// PutTripsTripID Update a trip.
+// (PUT /trips/{tripId})
+func (api ApiServer) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
+	id, err := api.tripID(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	var body spec.UpdateTripRequest
+	if err := decodeJSON(r, &body); err != nil {
+		var dateErr *spec.TripDateError
+		if errors.As(err, &dateErr) {
+			return respondError(http.StatusBadRequest, codeInvalidInput, dateErr.Error())
+		}
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if err := api.checkTripDuration(body.StartsAt, body.EndsAt); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "Trip not found", err)
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	// The deadline is editable until it passes, then only the same value is
+	// accepted so the rest of the trip can still be updated.
+	if rsvpClosed(trip) {
+		if !body.RsvpDeadline.Equal(trip.RsvpDeadline.Time) {
+			return respondError(http.StatusBadRequest, codeRSVPClosed, "rsvp deadline passed, it can't be changed anymore")
+		}
+		if !body.RsvpDeadline.Before(body.StartsAt) {
+			return respondError(http.StatusBadRequest, codeInvalidInput, "rsvp_deadline must be before starts_at")
+		}
+	} else if !body.RsvpDeadline.IsZero() {
+		if err := checkRSVPDeadline(body.RsvpDeadline, body.StartsAt); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	// Confirmed participants hear about new dates or destination unless the
+	// owner opts out, like for a typo fix.
+	notify := params.Notify == nil || *params.Notify
+	if _, err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripIfVersionParams{
+		Destination:   body.Destination,
+		StartsAt:      pgtype.Timestamptz{Valid: true, Time: body.StartsAt},
+		EndsAt:        pgtype.Timestamptz{Valid: true, Time: body.EndsAt},
+		IsConfirmed:   trip.IsConfirmed,
+		InviteMessage: pgtype.Text{Valid: body.InviteMessage != "", String: body.InviteMessage},
+		RsvpDeadline:  pgtype.Timestamptz{Valid: !body.RsvpDeadline.IsZero(), Time: body.RsvpDeadline},
+		ReplyTo:       pgtype.Text{Valid: body.ReplyTo != "", String: string(body.ReplyTo)},
+		ID:            id,
+		Version:       body.Version,
+	}, notify); err != nil {
+		var conflict *pgstore.VersionConflictError
+		if errors.As(err, &conflict) {
+			return spec.PutTripsTripIDJSON409Response(spec.VersionConflictResponse{
+				Message: "trip was changed by someone else, fetch it again",
+				Version: conflict.Current,
+			})
+		}
+		api.log(r.Context()).Error("failed to update trip", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.PutTripsTripIDJSON204Response(nil)
+}
+
+// PostTripsTripIDPublish Publish a draft trip and send the owner confirmation e-mail.
+// (POST /trips/{tripId}/publish)
+func (api ApiServer) PostTripsTripIDPublish(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	if !trip.IsDraft {
+		return respondError(http.StatusBadRequest, codeAlreadyPublished, "trip already published")
+	}
+
+	// Drafts skip the date rules on creation, publishing enforces the full set.
+	draft := spec.UpdateTripRequest{
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time,
+		EndsAt:      trip.EndsAt.Time,
+		Version:     trip.Version,
+	}
+	if err := api.validator.Struct(draft); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if err := api.checkTripDuration(draft.StartsAt, draft.EndsAt); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	// A concurrent publish may have won since the trip was read, only the
+	// call that clears the draft flag mails the owner.
+	published, err := api.store.PublishTrip(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to publish trip", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+	if !published {
+		return respondError(http.StatusBadRequest, codeAlreadyPublished, "trip already published")
+	}
+
+	return spec.PostTripsTripIDPublishJSON204Response(nil)
+}
+
+// PostActivitiesBatch Get the activities of several trips at once.
+// (POST /activities/batch)
+func (api ApiServer) PostActivitiesBatch(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.GetActivitiesBatchRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	ids := make([]uuid.UUID, 0, len(body.TripIds))
+	seen := make(map[uuid.UUID]bool, len(body.TripIds))
+	for _, raw := range body.TripIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid: "+raw)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	activities, err := api.store.GetActivitiesForTrips(r.Context(), ids)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities for trips", zap.Error(err), zap.Int("trips", len(ids)))
+		return storeFailure(r.Context(), err)
+	}
+
+	byTrip := make(map[uuid.UUID][]pgstore.Activity, len(ids))
+	for _, activity := range activities {
+		byTrip[activity.TripID] = append(byTrip[activity.TripID], activity)
+	}
+
+	// The activities are grouped by day in the time zone of their trip.
+	trips, err := api.store.GetTripsByIDs(r.Context(), ids)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trips", zap.Error(err), zap.Int("trips", len(ids)))
+		return storeFailure(r.Context(), err)
+	}
+	locations := make(map[uuid.UUID]*time.Location, len(trips))
+	for _, trip := range trips {
+		locations[trip.ID] = api.tripLocation(r.Context(), trip)
+	}
+
+	// Every requested trip is answered, in request order, unknown ones and
+	// trips without activities just get an empty list.
+	response := spec.GetActivitiesBatchResponse{Trips: make([]spec.GetActivitiesBatchResponseTrip, 0, len(ids))}
+	for _, id := range ids {
+		tz, ok := locations[id]
+		if !ok {
+			tz = time.UTC
+		}
+		response.Trips = append(response.Trips, spec.GetActivitiesBatchResponseTrip{
+			TripID:     id.String(),
+			Activities: mapActivities(byTrip[id], tz, nil),
+		})
+	}
+
+	return spec.PostActivitiesBatchJSON200Response(response)
+}
+
+// maxActivityQuery caps the activity search, titles are short anyway.
+const maxActivityQuery = 100
+
+// GetTripsTripIDActivities Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (api ApiServer) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	loc, err := requestLocale(r, params.Locale)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+	w.Header().Add("Vary", "Accept-Language")
+
+	var query string
+	if params.Q != nil {
+		query = strings.TrimSpace(*params.Q)
+	}
+	if utf8.RuneCountInString(query) > maxActivityQuery {
+		return respondError(http.StatusBadRequest, codeInvalidInput, fmt.Sprintf("q must be at most %d characters", maxActivityQuery))
+	}
+
+	tripActivities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{
+		TripID: id,
+		Query:  escapeLike(query),
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trips", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	responseActivities := mapActivities(tripActivities, api.tripLocation(r.Context(), trip), loc)
+
+	response := spec.GetTripActivitiesResponse{
+		Activities: responseActivities,
+	}
+
+	return withETag(w, r, response, spec.GetTripsTripIDActivitiesJSON200Response)
+}
+
+// GetTripsTripIDActivitiesStats Get aggregate statistics of a trip activities.
+// (GET /trips/{tripId}/activities/stats)
+func (api ApiServer) GetTripsTripIDActivitiesStats(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	stats, err := api.store.GetTripActivityStats(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity stats", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	days, err := api.store.GetTripActivityCountsPerDay(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity counts per day", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.GetTripActivityStatsResponse{
+		Total:  stats.Total,
+		PerDay: make([]spec.GetTripActivityStatsResponseDay, 0, len(days)),
+	}
+	response.EarliestAt = utcOrNil(stats.EarliestAt)
+	response.LatestAt = utcOrNil(stats.LatestAt)
+	for _, day := range days {
+		response.PerDay = append(response.PerDay, spec.GetTripActivityStatsResponseDay{
+			Date:  utc(day.Day),
+			Total: day.Total,
+		})
+	}
+
+	return spec.GetTripsTripIDActivitiesStatsJSON200Response(response)
+}
+
+// mapActivities groups the activities by calendar day in tz, the trip time
+// zone. They must come sorted by day, pinned first and then by occurs_at
+// within a day, like the store returns them, so the groups are folded in one
+// pass and listed in order. The formatted dates are in tz as well. The result
+// is never nil, so a trip without activities is answered with [] rather
+// than null.
+func mapActivities(activities []pgstore.Activity, tz *time.Location, loc *dateLocale) []spec.GetTripActivitiesResponseOuterArray {
+	outerActivities := []spec.GetTripActivitiesResponseOuterArray{}
+	for _, activity := range activities {
+		occursAt := utc(activity.OccursAt)
+		y, m, d := occursAt.In(tz).Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, tz)
+
+		last := len(outerActivities) - 1
+		if last < 0 || !outerActivities[last].Date.Equal(day) {
+			outerActivity := spec.GetTripActivitiesResponseOuterArray{Date: day.UTC()}
+			if loc != nil {
+				outerActivity.DateFormatted = loc.date(day)
+			}
+			outerActivities = append(outerActivities, outerActivity)
+			last++
+		}
+
+		innerActivity := spec.GetTripActivitiesResponseInnerArray{
+			ID:              activity.ID.String(),
+			OccursAt:        occursAt,
+			Title:           activity.Title,
+			Pinned:          activity.Pinned,
+			Latitude:        coordinateOrNil(activity.Latitude),
+			Longitude:       coordinateOrNil(activity.Longitude),
+			DurationMinutes: durationMinutesOrNil(activity.DurationMinutes),
+		}
+		if activity.RecurrenceGroup.Valid {
+			innerActivity.RecurrenceGroup = uuid.UUID(activity.RecurrenceGroup.Bytes).String()
+		}
+		if loc != nil {
+			innerActivity.OccursAtFormatted = loc.dateTime(occursAt.In(tz))
+		}
+		outerActivities[last].Activities = append(outerActivities[last].Activities, innerActivity)
+	}
+
+	return outerActivities
+}
+
+// PatchTripsTripIDActivitiesActivityIDPin Pin or unpin an activity.
+// (PATCH /trips/{tripId}/activities/{activityId}/pin)
+func (api ApiServer) PatchTripsTripIDActivitiesActivityIDPin(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.PatchTripsTripIDActivitiesActivityIDPinParams) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	if resp := api.checkActivitiesLocked(trip); resp != nil {
+		return resp
+	}
+
+	aid, err := uuid.Parse(activityID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var scope string
+	if params.Scope != nil {
+		scope = string(*params.Scope)
+	}
+	group, err := wholeGroup(scope)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	var body spec.PinActivityRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	updated, err := api.store.SetActivityPinned(r.Context(), pgstore.SetActivityPinnedParams{
+		Pinned:     body.Pinned,
+		TripID:     trip.ID,
+		ID:         aid,
+		WholeGroup: group,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to pin activity", zap.Error(err), zap.String("tripID", tripID), zap.String("activityID", activityID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if len(updated) == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "activity not found")
+	}
+
+	for _, id := range updated {
+		api.events.publish(trip.ID, tripEvent{
+			Type:     eventActivityUpdated,
+			Activity: tripEventActivity{ID: id.String(), Pinned: &body.Pinned},
+		})
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDPinJSON204Response(nil)
+}
+
+// DeleteTripsTripIDActivitiesActivityID Delete an activity, or every occurrence of a recurring one.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api ApiServer) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.DeleteTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	if resp := api.checkActivitiesLocked(trip); resp != nil {
+		return resp
+	}
+
+	aid, err := uuid.Parse(activityID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var scope string
+	if params.Scope != nil {
+		scope = string(*params.Scope)
+	}
+	group, err := wholeGroup(scope)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	removed, err := api.store.DeleteActivities(r.Context(), pgstore.DeleteActivitiesParams{
+		TripID:     trip.ID,
+		ID:         aid,
+		WholeGroup: group,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete activity", zap.Error(err), zap.String("tripID", tripID), zap.String("activityID", activityID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if len(removed) == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "activity not found")
+	}
+
+	for _, id := range removed {
+		api.events.publish(trip.ID, tripEvent{
+			Type:     eventActivityDeleted,
+			Activity: tripEventActivity{ID: id.String()},
+		})
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// GetTripsTripIDActivitiesToday Get the activities of today in the trip time zone.
+// (GET /trips/{tripId}/activities/today)
+func (api ApiServer) GetTripsTripIDActivitiesToday(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	loc := api.tripLocation(r.Context(), trip)
+
+	// AddDate keeps the end at midnight on days a DST change makes 23 or 25
+	// hours long.
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 0, 1)
+
+	activities, err := api.store.GetTripActivitiesBetween(r.Context(), pgstore.GetTripActivitiesBetweenParams{
+		TripID: trip.ID,
+		From:   pgtype.Timestamptz{Time: start, Valid: true},
+		To:     pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get today activities", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.GetTodayActivitiesResponse{
+		Date:       openapi_types.Date{Time: start},
+		Timezone:   loc.String(),
+		Activities: make([]spec.GetTripActivitiesResponseInnerArray, 0, len(activities)),
+	}
+	for _, activity := range activities {
+		response.Activities = append(response.Activities, spec.GetTripActivitiesResponseInnerArray{
+			ID:              activity.ID.String(),
+			OccursAt:        utc(activity.OccursAt),
+			Title:           activity.Title,
+			Pinned:          activity.Pinned,
+			Latitude:        coordinateOrNil(activity.Latitude),
+			Longitude:       coordinateOrNil(activity.Longitude),
+			DurationMinutes: durationMinutesOrNil(activity.DurationMinutes),
+		})
+	}
+
+	return spec.GetTripsTripIDActivitiesTodayJSON200Response(response)
+}
+
+// GetParticipantsActivities List the activities of the trips a participant confirmed.
+// (GET /participants/activities)
+func (api ApiServer) GetParticipantsActivities(w http.ResponseWriter, r *http.Request, params spec.GetParticipantsActivitiesParams) *spec.Response {
+	email := normalizeEmail(params.Email)
+	if email == "" {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "email is required")
+	}
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err == nil {
+		err = cursorOrOffset(params.Cursor, params.Offset)
+	}
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	after := firstActivityCursor
+	if params.Cursor != nil {
+		if after, err = decodeActivityCursor(*params.Cursor); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	// One activity more than the page tells whether there is a next one.
+	activities, err := api.store.GetParticipantActivities(r.Context(), pgstore.GetParticipantActivitiesParams{
+		Email:         email,
+		IncludePast:   params.IncludePast != nil && *params.IncludePast,
+		AfterOccursAt: after.occursAt,
+		AfterID:       after.id,
+		Limit:         limit + 1,
+		Offset:        offset,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participant activities", zap.Error(err), zap.String("email", redactEmail(email)))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.GetParticipantActivitiesResponse{
+		Activities: make([]spec.ParticipantActivity, 0, min(len(activities), int(limit))),
+	}
+	if len(activities) > int(limit) {
+		activities = activities[:limit]
+		last := activities[len(activities)-1]
+		next := activityCursor{occursAt: last.OccursAt, id: last.ID}.encode()
+		response.NextCursor = &next
+	}
+	for _, activity := range activities {
+		item := spec.GetTripActivitiesResponseInnerArray{
+			ID:              activity.ID.String(),
+			OccursAt:        utc(activity.OccursAt),
+			Title:           activity.Title,
+			Pinned:          activity.Pinned,
+			Latitude:        coordinateOrNil(activity.Latitude),
+			Longitude:       coordinateOrNil(activity.Longitude),
+			DurationMinutes: durationMinutesOrNil(activity.DurationMinutes),
+		}
+		if activity.RecurrenceGroup.Valid {
+			item.RecurrenceGroup = uuid.UUID(activity.RecurrenceGroup.Bytes).String()
+		}
+		response.Activities = append(response.Activities, spec.ParticipantActivity{
+			TripID:      activity.TripID.String(),
+			Destination: activity.Destination,
+			Activity:    item,
+		})
+	}
+
+	return spec.GetParticipantsActivitiesJSON200Response(response)
+}
+
+// GetTripsTripIDActivitiesDuplicates Get the groups of activities sharing the same title and time.
+// (GET /trips/{tripId}/activities/duplicates)
+func (api ApiServer) GetTripsTripIDActivitiesDuplicates(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	activities, err := api.store.GetDuplicateActivities(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get duplicate activities", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	// Rows come sorted by group and then age, so each group is a run.
+	groups := []spec.GetDuplicateActivitiesResponseGroup{}
+	for _, activity := range activities {
+		last := len(groups) - 1
+		if last < 0 || groups[last].Title != activity.Title || !groups[last].OccursAt.Equal(activity.OccursAt.Time) {
+			groups = append(groups, spec.GetDuplicateActivitiesResponseGroup{
+				Title:    activity.Title,
+				OccursAt: utc(activity.OccursAt),
+			})
+			last++
+		}
+		groups[last].ActivityIds = append(groups[last].ActivityIds, activity.ID.String())
+	}
+
+	return spec.GetTripsTripIDActivitiesDuplicatesJSON200Response(spec.GetDuplicateActivitiesResponse{Groups: groups})
+}
+
+// PostTripsTripIDActivitiesDedupe Delete duplicated activities, keeping the earliest created of each group.
+// (POST /trips/{tripId}/activities/dedupe)
+func (api ApiServer) PostTripsTripIDActivitiesDedupe(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	if resp := api.checkActivitiesLocked(trip); resp != nil {
+		return resp
+	}
+
+	removed, err := api.store.DeleteDuplicateActivities(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete duplicate activities", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	for _, activityID := range removed {
+		api.events.publish(id, tripEvent{
+			Type:     eventActivityDeleted,
+			Activity: tripEventActivity{ID: activityID.String()},
+		})
+	}
+
+	return spec.PostTripsTripIDActivitiesDedupeJSON200Response(spec.DedupeActivitiesResponse{Removed: int64(len(removed))})
+}
+
+// GetTripsTripIDScheduleValidate Check a trip schedule for overlapping activities and idle gaps.
+// (GET /trips/{tripId}/schedule/validate)
+func (api ApiServer) GetTripsTripIDScheduleValidate(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDScheduleValidateParams) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	gap := defaultScheduleGap
+	if params.GapMinutes != nil {
+		gap = *params.GapMinutes
+	}
+	if gap < 1 || gap > 1440 {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "gap_minutes must be between 1 and 1440")
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: trip.ID})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+	// The listing puts pinned activities first within a day, the schedule
+	// is checked in time order.
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].OccursAt.Time.Before(activities[j].OccursAt.Time)
+	})
+
+	return spec.GetTripsTripIDScheduleValidateJSON200Response(spec.ValidateScheduleResponse{
+		GapMinutes: gap,
+		Issues:     checkSchedule(activities, api.tripLocation(r.Context(), trip), time.Duration(gap)*time.Minute),
+	})
+}
+
+// PostTripsTripIDActivities Create a trip activity.
+// (POST /trips/{tripId}/activities)
+func (api ApiServer) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	if resp := api.checkActivitiesLocked(trip); resp != nil {
+		return resp
+	}
+
+	var body spec.CreateActivityRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if trip.StartsAt.Valid && trip.EndsAt.Valid &&
+		(body.OccursAt.Before(trip.StartsAt.Time) || body.OccursAt.After(trip.EndsAt.Time)) {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "activity must happen during the trip")
+	}
+	if err := checkActivityEnd(trip, body.OccursAt, body.DurationMinutes); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	remindBefore := pgtype.Int4{}
+	if body.RemindBefore != nil {
+		remindBefore = pgtype.Int4{Int32: int32(*body.RemindBefore), Valid: true}
+	}
+
+	if body.Recurrence != nil {
+		return api.createRecurringActivity(r, trip, body, remindBefore)
+	}
+
+	activityID, err := api.store.CreateActivity(r.Context(), pgstore.CreateActivityParams{
+		ID:                 pgstore.NewID(),
+		TripID:             id,
+		Title:              body.Title,
+		OccursAt:           pgtype.Timestamptz{Time: body.OccursAt, Valid: true},
+		RemindBefore:       remindBefore,
+		RemindParticipants: body.RemindParticipants,
+		Latitude:           coordinate(body.Latitude),
+		Longitude:          coordinate(body.Longitude),
+		DurationMinutes:    durationMinutes(body.DurationMinutes),
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create activity", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	occursAt := body.OccursAt.UTC()
+	api.events.publish(id, tripEvent{
+		Type:     eventActivityCreated,
+		Activity: tripEventActivity{ID: activityID.String(), Title: body.Title, OccursAt: &occursAt, DurationMinutes: body.DurationMinutes},
+	})
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityID.String()})
+}
+
+// createRecurringActivity expands the recurrence of body and inserts every
+// occurrence at once, under a new recurrence group.
+func (api ApiServer) createRecurringActivity(r *http.Request, trip pgstore.Trip, body spec.CreateActivityRequest, remindBefore pgtype.Int4) *spec.Response {
+	if !trip.StartsAt.Valid || !trip.EndsAt.Valid {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "recurring activities need a trip with start and end dates")
+	}
+
+	occurrences, err := expandRecurrence(body.OccursAt, *body.Recurrence, trip.EndsAt.Time, api.tripLocation(r.Context(), trip))
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+	// The first occurrence was checked already, the last one ends latest.
+	if err := checkActivityEnd(trip, occurrences[len(occurrences)-1], body.DurationMinutes); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	group := pgtype.UUID{Bytes: pgstore.NewID(), Valid: true}
+	params := make([]pgstore.CreateActivityParams, len(occurrences))
+	for i, occursAt := range occurrences {
+		params[i] = pgstore.CreateActivityParams{
+			ID:                 pgstore.NewID(),
+			TripID:             trip.ID,
+			Title:              body.Title,
+			OccursAt:           pgtype.Timestamptz{Time: occursAt, Valid: true},
+			RemindBefore:       remindBefore,
+			RemindParticipants: body.RemindParticipants,
+			RecurrenceGroup:    group,
+			Latitude:           coordinate(body.Latitude),
+			Longitude:          coordinate(body.Longitude),
+			DurationMinutes:    durationMinutes(body.DurationMinutes),
+		}
+	}
+
+	ids, err := api.store.CreateActivities(r.Context(), params)
+	if err != nil {
+		api.log(r.Context()).Error("failed to create recurring activity", zap.Error(err), zap.String("tripID", trip.ID.String()), zap.Int("occurrences", len(params)))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.CreateActivityResponse{
+		ActivityID:      ids[0].String(),
+		RecurrenceGroup: uuid.UUID(group.Bytes).String(),
+		OccurrenceIds:   make([]string, len(ids)),
+	}
+	for i, id := range ids {
+		response.OccurrenceIds[i] = id.String()
+		occursAt := occurrences[i].UTC()
+		api.events.publish(trip.ID, tripEvent{
+			Type:     eventActivityCreated,
+			Activity: tripEventActivity{ID: id.String(), Title: body.Title, OccursAt: &occursAt, DurationMinutes: body.DurationMinutes},
+		})
+	}
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(response)
+}
+
+// GetTripsTripIDConfirm Confirm a trip and send e-mail invitations.
+// (GET /trips/{tripId}/confirm)
+func (api ApiServer) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	if trip.IsDraft {
+		return respondError(http.StatusBadRequest, codeTripDraft, "trip is a draft, publish it first")
+	}
+
+	// Confirming again is a no-op, the invites went out with the first one.
+	if _, err := api.store.ConfirmTrip(r.Context(), id); err != nil {
+		api.log(r.Context()).Error("failed to confirm trip", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+}
+
+// PatchTripsTripIDOwnerEmail Change the trip owner e-mail.
+// (PATCH /trips/{tripId}/owner-email)
+func (api ApiServer) PatchTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	var body spec.ChangeOwnerEmailRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if api.blocklist.Blocked(string(body.Email)) {
+		return respondError(http.StatusBadRequest, codeEmailDomainBlocked, "e-mail domain not allowed")
+	}
+
+	if strings.EqualFold(string(body.Email), trip.OwnerEmail) {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "email is already the owner e-mail")
+	}
+
+	// The trip keeps its owner e-mail until the new one is verified.
+	if err := api.store.RequestOwnerEmailChange(r.Context(), trip, string(body.Email), ownerEmailChangeTTL); err != nil {
+		api.log(r.Context()).Error("failed to request owner e-mail change", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.PatchTripsTripIDOwnerEmailJSON202Response(nil)
+}
+
+// GetTripsTripIDOwnerEmailVerify Verify a new trip owner e-mail and make it the owner address.
+// (GET /trips/{tripId}/owner-email/verify)
+func (api ApiServer) GetTripsTripIDOwnerEmailVerify(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDOwnerEmailVerifyParams) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	verified, err := api.store.VerifyOwnerEmailChange(r.Context(), pgstore.VerifyOwnerEmailChangeParams{
+		Token:  params.Token,
+		TripID: trip.ID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to verify owner e-mail change", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if verified == 0 {
+		return respondError(http.StatusGone, codeTokenExpired, "verification link is invalid, expired or already used")
+	}
+
+	return spec.GetTripsTripIDOwnerEmailVerifyJSON204Response(nil)
+}
+
+// PostTripsTripIDInvites Invite someone to the trip.
+// (POST /trips/{tripId}/invites)
+func (api ApiServer) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	var body spec.InviteParticipantRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if api.blocklist.Blocked(string(body.Email)) {
+		return respondError(http.StatusBadRequest, codeEmailDomainBlocked, "e-mail domain not allowed")
+	}
+
+	if err := api.checkPlusOnes(body.PlusOnes); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	if _, err := api.store.InviteParticipantToTrip(r.Context(), pgstore.InviteParticipantToTripParams{
+		ID:            pgstore.NewID(),
+		TripID:        id,
+		Email:         string(body.Email),
+		InviteMessage: pgtype.Text{Valid: body.InviteMessage != "", String: body.InviteMessage},
+		ExpiresAt:     pgstore.InviteExpiry(trip.StartsAt, api.inviteTTL),
+		PlusOnes:      int32(body.PlusOnes),
+	}); err != nil {
+		api.log(r.Context()).Error("failed to invite participant", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	suppressed := api.suppressedEmails(r.Context(), []string{string(body.Email)})
+
+	return spec.PostTripsTripIDInvitesJSON201Response(spec.InviteParticipantResponse{Suppressed: len(suppressed) > 0})
+}
+
+// GetTripsTripIDLinks Get a trip links.
+// (GET /trips/{tripId}/links)
+func (api ApiServer) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	links, err := api.store.GetTripLinks(r.Context(), trip.ID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip links", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	responseLinks := make([]spec.GetLinksResponseArray, 0, len(links))
+	for _, link := range links {
+		responseLinks = append(responseLinks, spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		})
+	}
+
+	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{Links: responseLinks})
+}
+
+// GetTripsTripIDLinksLinkID Get a single trip link.
+// (GET /trips/{tripId}/links/{linkId})
+func (api ApiServer) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	link, err := api.store.GetTripLink(r.Context(), pgstore.GetTripLinkParams{ID: lid, TripID: id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return respondErrorCause(r.Context(), http.StatusNotFound, codeNotFound, "link not found", err)
+		}
+		api.log(r.Context()).Error("failed to get trip link", zap.Error(err), zap.String("tripID", tripID), zap.String("linkID", linkID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetTripsTripIDLinksLinkIDJSON200Response(spec.GetLinkResponse{
+		Link: spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		},
+	})
+}
+
+// PutTripsTripIDLinksLinkID Update a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api ApiServer) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var body spec.UpdateLinkRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	linkURL, err := normalizeLinkURL(body.URL)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	updated, err := api.store.UpdateTripLink(r.Context(), pgstore.UpdateTripLinkParams{
+		Title:  body.Title,
+		Url:    linkURL,
+		ID:     lid,
+		TripID: id,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to update trip link", zap.Error(err), zap.String("tripID", tripID), zap.String("linkID", linkID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if updated == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "link not found")
+	}
+
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// PostTripsTripIDLinks Create a trip link.
+// (POST /trips/{tripId}/links)
+func (api ApiServer) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	var body spec.CreateLinkRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	linkURL, err := normalizeLinkURL(body.URL)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	id, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
+		ID:     pgstore.NewID(),
+		TripID: trip.ID,
+		Title:  body.Title,
+		Url:    linkURL,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create trip link", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{LinkID: id.String()})
+}
+
+// PostTripsTripIDExpenses Log a shared cost of the trip.
+// (POST /trips/{tripId}/expenses)
+func (api ApiServer) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	var body spec.CreateExpenseRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	payerID, err := uuid.Parse(body.PayerID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	payer, err := api.store.GetParticipant(r.Context(), payerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.PayerID))
+		return storeFailure(r.Context(), err)
+	}
+	if err != nil || payer.TripID != trip.ID {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "payer is not a participant of the trip")
+	}
+
+	var activityID pgtype.UUID
+	if body.ActivityID != "" {
+		aid, err := uuid.Parse(body.ActivityID)
+		if err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+		}
+
+		activity, err := api.store.GetActivity(r.Context(), aid)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			api.log(r.Context()).Error("failed to get activity", zap.Error(err), zap.String("activityID", body.ActivityID))
+			return storeFailure(r.Context(), err)
+		}
+		if err != nil || activity.TripID != trip.ID {
+			return respondError(http.StatusBadRequest, codeInvalidInput, "activity is not part of the trip")
+		}
+		activityID = pgtype.UUID{Bytes: aid, Valid: true}
+	}
+
+	id := pgstore.NewID()
+	created, err := api.store.CreateExpense(r.Context(), pgstore.CreateExpenseParams{
+		ID:          id,
+		TripID:      trip.ID,
+		Description: body.Description,
+		Amount:      body.Amount,
+		Currency:    body.Currency,
+		PayerID:     payerID,
+		ActivityID:  activityID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create expense", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if created == 0 {
+		return respondError(http.StatusConflict, codeCurrencyMismatch, "the trip already has expenses in another currency")
+	}
+
+	return spec.PostTripsTripIDExpensesJSON201Response(spec.CreateExpenseResponse{ExpenseID: id.String()})
+}
+
+// GetTripsTripIDExpenses Get the expenses of a trip, oldest first.
+// (GET /trips/{tripId}/expenses)
+func (api ApiServer) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	expenses, err := api.store.GetTripExpenses(r.Context(), trip.ID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip expenses", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	items := make([]spec.TripExpense, len(expenses))
+	for i, expense := range expenses {
+		items[i] = spec.TripExpense{
+			ID:          expense.ID.String(),
+			Description: expense.Description,
+			Amount:      expense.Amount,
+			Currency:    expense.Currency,
+			PayerID:     expense.PayerID.String(),
+			CreatedAt:   expense.CreatedAt.Time,
+		}
+		if expense.ActivityID.Valid {
+			items[i].ActivityID = uuid.UUID(expense.ActivityID.Bytes).String()
+		}
+	}
+
+	return spec.GetTripsTripIDExpensesJSON200Response(spec.GetTripExpensesResponse{Expenses: items})
+}
+
+// GetTripsTripIDExpensesSummary Get what each participant paid and owes.
+// (GET /trips/{tripId}/expenses/summary)
+func (api ApiServer) GetTripsTripIDExpensesSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	totals, err := api.store.GetTripExpenseTotals(r.Context(), trip.ID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to total trip expenses", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	participants, err := api.store.GetParticipantsForTrips(r.Context(), []uuid.UUID{trip.ID})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	summary, err := summarizeExpenses(totals, participants[trip.ID])
+	if err != nil {
+		api.log(r.Context()).Warn("can't summarize trip expenses", zap.Error(err), zap.String("tripID", tripID))
+		return respondError(http.StatusConflict, codeCurrencyMismatch, err.Error())
+	}
+
+	return spec.GetTripsTripIDExpensesSummaryJSON200Response(summary)
+}
+
+// DeleteTripsTripIDExpensesExpenseID Delete an expense.
+// (DELETE /trips/{tripId}/expenses/{expenseId})
+func (api ApiServer) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	eid, err := uuid.Parse(expenseID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	deleted, err := api.store.DeleteExpense(r.Context(), pgstore.DeleteExpenseParams{ID: eid, TripID: trip.ID})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete expense", zap.Error(err), zap.String("tripID", tripID), zap.String("expenseID", expenseID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if deleted == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "expense not found")
+	}
+
+	return spec.DeleteTripsTripIDExpensesExpenseIDJSON204Response(nil)
+}
+
+// PostTripsTripIDChecklist Add an item to the trip checklist.
+// (POST /trips/{tripId}/checklist)
+func (api ApiServer) PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	var body spec.CreateChecklistItemRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	assignee, resp := api.checklistAssignee(r.Context(), trip, body.ParticipantID, body.Owner)
+	if resp != nil {
+		return resp
+	}
+
+	id := pgstore.NewID()
+	if err := api.store.CreateChecklistItem(r.Context(), pgstore.CreateChecklistItemParams{
+		ID:              id,
+		TripID:          trip.ID,
+		Title:           body.Title,
+		AssigneeID:      assignee,
+		AssignedToOwner: body.Owner,
+	}); err != nil {
+		api.log(r.Context()).Error("failed to create checklist item", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.PostTripsTripIDChecklistJSON201Response(spec.CreateChecklistItemResponse{ItemID: id.String()})
+}
+
+// GetTripsTripIDChecklist Get the trip checklist grouped by assignee.
+// (GET /trips/{tripId}/checklist)
+func (api ApiServer) GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	items, err := api.store.GetTripChecklist(r.Context(), trip.ID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trip checklist", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetTripsTripIDChecklistJSON200Response(spec.GetTripChecklistResponse{Groups: groupChecklist(items)})
+}
+
+// PatchTripsTripIDChecklistItemIDAssignee Assign a checklist item to a participant or the owner, or unassign it.
+// (PATCH /trips/{tripId}/checklist/{itemId}/assignee)
+func (api ApiServer) PatchTripsTripIDChecklistItemIDAssignee(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	iid, err := uuid.Parse(itemID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var body spec.AssignChecklistItemRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	assignee, resp := api.checklistAssignee(r.Context(), trip, body.ParticipantID, body.Owner)
+	if resp != nil {
+		return resp
+	}
+
+	updated, err := api.store.AssignChecklistItem(r.Context(), pgstore.AssignChecklistItemParams{
+		AssigneeID:      assignee,
+		AssignedToOwner: body.Owner,
+		ID:              iid,
+		TripID:          trip.ID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to assign checklist item", zap.Error(err), zap.String("tripID", tripID), zap.String("itemID", itemID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if updated == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "checklist item not found")
+	}
+
+	return spec.PatchTripsTripIDChecklistItemIDAssigneeJSON204Response(nil)
+}
+
+// PatchTripsTripIDChecklistItemIDDone Mark a checklist item done or not done.
+// (PATCH /trips/{tripId}/checklist/{itemId}/done)
+func (api ApiServer) PatchTripsTripIDChecklistItemIDDone(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	iid, err := uuid.Parse(itemID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var body spec.MarkChecklistItemDoneRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	updated, err := api.store.SetChecklistItemDone(r.Context(), pgstore.SetChecklistItemDoneParams{
+		Done:   body.Done,
+		ID:     iid,
+		TripID: trip.ID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to mark checklist item", zap.Error(err), zap.String("tripID", tripID), zap.String("itemID", itemID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if updated == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "checklist item not found")
+	}
+
+	return spec.PatchTripsTripIDChecklistItemIDDoneJSON204Response(nil)
+}
+
+// DeleteTripsTripIDChecklistItemID Delete a checklist item.
+// (DELETE /trips/{tripId}/checklist/{itemId})
+func (api ApiServer) DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	iid, err := uuid.Parse(itemID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	deleted, err := api.store.DeleteChecklistItem(r.Context(), pgstore.DeleteChecklistItemParams{ID: iid, TripID: trip.ID})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete checklist item", zap.Error(err), zap.String("tripID", tripID), zap.String("itemID", itemID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if deleted == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "checklist item not found")
+	}
+
+	return spec.DeleteTripsTripIDChecklistItemIDJSON204Response(nil)
+}
+
+// GetTripsTripIDDateOptions Get the trip date options with their votes.
+// (GET /trips/{tripId}/date-options)
+func (api ApiServer) GetTripsTripIDDateOptions(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	tallies, err := api.store.GetTripDateOptionTallies(r.Context(), trip.ID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get date options", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	options := make([]spec.TripDateOption, 0, len(tallies))
+	for _, tally := range tallies {
+		options = append(options, spec.TripDateOption{
+			ID:       tally.ID.String(),
+			StartsAt: utc(tally.StartsAt),
+			EndsAt:   utc(tally.EndsAt),
+			Votes:    int(tally.Votes),
+		})
+	}
+
+	return spec.GetTripsTripIDDateOptionsJSON200Response(spec.GetTripDateOptionsResponse{
+		IsPolling: trip.IsPolling,
+		Options:   options,
+	})
+}
+
+// PostTripsTripIDDateOptionsOptionIDVote Vote on a date option of the trip.
+// (POST /trips/{tripId}/date-options/{optionId}/vote)
+func (api ApiServer) PostTripsTripIDDateOptionsOptionIDVote(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	oid, err := uuid.Parse(optionID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	var body spec.VoteDateOptionRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	// The participant ID is the secret of the invite link, like on confirm,
+	// so it has to be one of this trip's.
+	pid, err := uuid.Parse(body.ParticipantID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pid)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.ParticipantID))
+		return storeFailure(r.Context(), err)
+	}
+	if err != nil || participant.TripID != trip.ID {
+		return respondError(http.StatusNotFound, codeNotFound, "participant not found")
+	}
+
+	if !trip.IsPolling {
+		return respondError(http.StatusConflict, codePollClosed, "the trip dates are already set")
+	}
+	if rsvpClosed(trip) {
+		return respondError(http.StatusGone, codeRSVPClosed, "rsvp deadline passed")
+	}
+	if inviteExpired(participant) {
+		return respondError(http.StatusGone, codeInviteExpired, "invite expired")
+	}
+
+	voted, err := api.store.VoteTripDateOption(r.Context(), pgstore.VoteTripDateOptionParams{
+		ParticipantID: participant.ID,
+		OptionID:      oid,
+		TripID:        trip.ID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to vote on date option", zap.Error(err), zap.String("tripID", tripID), zap.String("optionID", optionID))
+		return storeFailure(r.Context(), err)
+	}
+
+	// No row is an option of another trip, or a poll closed since the trip
+	// was read.
+	if voted == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "date option not found")
+	}
+
+	return spec.PostTripsTripIDDateOptionsOptionIDVoteJSON204Response(nil)
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelect Select a date option as the trip dates.
+// (POST /trips/{tripId}/date-options/{optionId}/select)
+func (api ApiServer) PostTripsTripIDDateOptionsOptionIDSelect(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	oid, err := uuid.Parse(optionID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	if !trip.IsPolling {
+		return respondError(http.StatusConflict, codePollClosed, "the trip dates are already set")
+	}
+
+	selected, err := api.store.SelectTripDateOption(r.Context(), trip.ID, oid)
+	if err != nil {
+		api.log(r.Context()).Error("failed to select date option", zap.Error(err), zap.String("tripID", tripID), zap.String("optionID", optionID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if !selected {
+		return respondError(http.StatusNotFound, codeNotFound, "date option not found")
+	}
+
+	return spec.PostTripsTripIDDateOptionsOptionIDSelectJSON204Response(nil)
+}
+
+// GetTripsTripIDSummary Get an overview of a trip.
+// (GET /trips/{tripId}/summary)
+func (api ApiServer) GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	links, err := api.store.CountTripLinks(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count links", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetTripsTripIDSummaryJSON200Response(spec.GetTripSummaryResponse{LinksCount: links})
+}
+
+// GetTripsTripIDParticipants Get a trip participants.
+// (GET /trips/{tripId}/participants)
+func (api ApiServer) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParticipantsParams) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	var query string
+	if params.Q != nil {
+		query = strings.TrimSpace(*params.Q)
+	}
+
+	limit, offset, err := pagination(params.Limit, params.Offset)
+	if err == nil {
+		err = cursorOrOffset(params.Cursor, params.Offset)
+	}
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+	}
+
+	var after participantCursor
+	if params.Cursor != nil {
+		if after, err = decodeParticipantCursor(*params.Cursor); err != nil {
+			return respondError(http.StatusBadRequest, codeInvalidInput, err.Error())
+		}
+	}
+
+	// One participant more than the page tells whether there is a next one.
+	participants, err := api.store.GetTripParticipants(r.Context(), pgstore.GetTripParticipantsParams{
+		TripID:     id,
+		Query:      escapeLike(query),
+		AfterEmail: after.email,
+		AfterID:    after.id,
+		Limit:      limit + 1,
+		Offset:     offset,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	var nextCursor *string
+	if len(participants) > int(limit) {
+		participants = participants[:limit]
+		last := participants[len(participants)-1]
+		next := participantCursor{email: last.Email, id: last.ID}.encode()
+		nextCursor = &next
+	}
+
+	responseParticipants := make([]spec.GetTripParticipantsResponseArray, 0, len(participants))
+	for _, participant := range participants {
+		var name *string
+		if participant.Name.Valid {
+			name = &participant.Name.String
+		}
+		responseParticipants = append(responseParticipants, spec.GetTripParticipantsResponseArray{
+			ID:          participant.ID.String(),
+			Name:        name,
+			Email:       openapi_types.Email(participant.Email),
+			IsConfirmed: participant.IsConfirmed,
+			ExpiresAt:   naiveUTCOrNil(participant.ExpiresAt),
+			IsExpired:   inviteExpired(participant),
+			PlusOnes:    int(participant.PlusOnes),
+			AvatarURL:   api.avatars.url(participant.Email),
+		})
+	}
+
+	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
+		Participants: responseParticipants,
+		NextCursor:   nextCursor,
+	})
+}
+
+// DeleteTripsTripIDParticipantsParticipantID Remove a participant from a trip.
+// (DELETE /trips/{tripId}/participants/{participantId})
+func (api ApiServer) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+	if err != nil || participant.TripID != trip.ID {
+		return respondError(http.StatusNotFound, codeNotFound, "participant not found")
+	}
+
+	// Participants carry no role, the owner is whoever uses the owner e-mail.
+	if strings.EqualFold(participant.Email, trip.OwnerEmail) {
+		return respondError(http.StatusForbidden, codeForbidden, "the trip owner can't be removed")
+	}
+
+	// Pending e-mails to the participant go with it, the outbox cascades.
+	removed, err := api.store.RemoveParticipant(r.Context(), pgstore.RemoveParticipantParams{ID: id, TripID: trip.ID})
+	if pgstore.IsExpensePayer(err) {
+		return respondError(http.StatusConflict, codeExpensePayer, "the participant paid expenses of the trip, delete them first")
+	}
+	if err != nil {
+		api.log(r.Context()).Error("failed to remove participant", zap.Error(err), zap.String("participant_id", participantID))
+		return storeFailure(r.Context(), err)
+	}
+
+	if removed == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "participant not found")
+	}
+
+	return spec.DeleteTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+}
+
+// GetTripsTripIDParticipantsStats Get how many people are invited and coming to a trip.
+// (GET /trips/{tripId}/participants/stats)
+func (api ApiServer) GetTripsTripIDParticipantsStats(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+	id := trip.ID
+
+	stats, err := api.store.GetTripParticipantStats(r.Context(), id)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participant stats", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	return spec.GetTripsTripIDParticipantsStatsJSON200Response(spec.GetTripParticipantStatsResponse{
+		Invited:   stats.Invited,
+		Confirmed: stats.Confirmed,
+		Headcount: stats.Headcount,
+	})
 }
@@ -0,0 +1,569 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+type testMailer struct{}
+
+func (testMailer) SendConfirmTripEmailToTripOwner(context.Context, uuid.UUID) error { return nil }
+func (testMailer) SendTestEmail(context.Context, string) error                      { return nil }
+
+// newTestHandler serves the API from store, mounted like the server does.
+func newTestHandler(store store) http.Handler {
+	si := NewAPI(store, zap.NewNop(), testMailer{}, nil, nil, unsubscribe.NewSigner("test"), Settings{
+		MaxTripDays:     30,
+		MaxPlusOnes:     2,
+		DefaultTimezone: "UTC",
+	})
+	return spec.Handler(&si, spec.WithErrorHandler(ParamError))
+}
+
+// newTestServer serves the API from an empty memStore.
+func newTestServer(t *testing.T) (*memStore, http.Handler) {
+	t.Helper()
+
+	store := newMemStore()
+	return store, newTestHandler(store)
+}
+
+// do runs one request with a JSON body, none when body is empty.
+func do(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// serve runs one request and returns the status and the error code of the
+// answer, empty when it isn't an error.
+func serve(t *testing.T, h http.Handler, method, path, body string) (int, string) {
+	t.Helper()
+
+	rec := do(h, method, path, body)
+	var failure spec.Error
+	if rec.Code >= http.StatusBadRequest {
+		if err := json.Unmarshal(rec.Body.Bytes(), &failure); err != nil {
+			t.Fatalf("%s %s: error body %q: %v", method, path, rec.Body.String(), err)
+		}
+	}
+	return rec.Code, string(failure.Code)
+}
+
+func addTrip(store *memStore, trip pgstore.Trip) pgstore.Trip {
+	if trip.ID == uuid.Nil {
+		trip.ID = pgstore.NewID()
+	}
+	if trip.Slug == "" {
+		trip.Slug = pgstore.NewSlug(trip.Destination)
+	}
+	store.trips[trip.ID] = trip
+	return trip
+}
+
+func addParticipant(store *memStore, participant pgstore.Participant) pgstore.Participant {
+	participant.ID = pgstore.NewID()
+	store.participants[participant.ID] = participant
+	return participant
+}
+
+func TestPostTrips(t *testing.T) {
+	startsAt := time.Now().AddDate(0, 1, 0).UTC().Format(time.RFC3339)
+	endsAt := time.Now().AddDate(0, 1, 5).UTC().Format(time.RFC3339)
+	tooLate := time.Now().AddDate(0, 3, 0).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		code   string
+	}{
+		{"invalid json", `{"destination":`, http.StatusBadRequest, codeInvalidJSON},
+		{"missing destination", `{"owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[],"starts_at":"` + startsAt + `","ends_at":"` + endsAt + `"}`, http.StatusBadRequest, codeInvalidInput},
+		{"missing dates", `{"destination":"Lisboa","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[]}`, http.StatusBadRequest, codeInvalidInput},
+		{"too long", `{"destination":"Lisboa","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[],"starts_at":"` + startsAt + `","ends_at":"` + tooLate + `"}`, http.StatusBadRequest, codeInvalidInput},
+		{"unknown timezone", `{"destination":"Lisboa","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[],"starts_at":"` + startsAt + `","ends_at":"` + endsAt + `","timezone":"Mars/Olympus"}`, http.StatusBadRequest, codeInvalidInput},
+		{"draft without dates", `{"destination":"Lisboa","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[],"draft":true}`, http.StatusCreated, ""},
+		{"trip", `{"destination":"Lisboa","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":["bia@example.com"],"starts_at":"` + startsAt + `","ends_at":"` + endsAt + `"}`, http.StatusCreated, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, h := newTestServer(t)
+
+			status, code := serve(t, h, http.MethodPost, "/trips", tt.body)
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+			if tt.status != http.StatusCreated {
+				if len(store.trips) != 0 {
+					t.Fatalf("refused trip was stored")
+				}
+				return
+			}
+
+			for _, trip := range store.trips {
+				if trip.Timezone != "UTC" {
+					t.Errorf("timezone %q, want the default", trip.Timezone)
+				}
+				if queued := len(store.emails) == 1; queued == trip.IsDraft {
+					t.Errorf("draft %t queued %d e-mails", trip.IsDraft, len(store.emails))
+				}
+			}
+		})
+	}
+}
+
+func TestGetTripsTripID(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	// Trips created before UUIDv7 keep their random v4 IDs.
+	old := addTrip(store, pgstore.Trip{ID: uuid.New(), Destination: "Porto", Timezone: "UTC"})
+
+	tests := []struct {
+		name   string
+		tripID string
+		status int
+		code   string
+	}{
+		{"by id", trip.ID.String(), http.StatusOK, ""},
+		{"by v4 id", old.ID.String(), http.StatusOK, ""},
+		{"by slug", trip.Slug, http.StatusOK, ""},
+		{"unknown id", uuid.NewString(), http.StatusNotFound, codeNotFound},
+		{"unknown slug", "nowhere-123", http.StatusNotFound, codeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodGet, "/trips/"+tt.tripID, "")
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+}
+
+func TestPatchParticipantsParticipantIDConfirm(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	closed := addTrip(store, pgstore.Trip{
+		Destination:  "Porto",
+		Timezone:     "UTC",
+		RsvpDeadline: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+	})
+
+	pending := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "bia@example.com"})
+	withPlusOnes := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "caio@example.com"})
+	confirmed := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "duda@example.com", IsConfirmed: true})
+	expired := addParticipant(store, pgstore.Participant{
+		TripID:    trip.ID,
+		Email:     "edu@example.com",
+		ExpiresAt: pgtype.Timestamp{Time: time.Now().UTC().Add(-time.Hour), Valid: true},
+	})
+	late := addParticipant(store, pgstore.Participant{TripID: closed.ID, Email: "fabi@example.com"})
+
+	tests := []struct {
+		name          string
+		participantID string
+		body          string
+		status        int
+		code          string
+	}{
+		{"invalid id", "not-a-uuid", "", http.StatusBadRequest, codeInvalidID},
+		{"unknown", uuid.NewString(), "", http.StatusNotFound, codeNotFound},
+		{"too many plus ones", pending.ID.String(), `{"plus_ones":3}`, http.StatusBadRequest, codeInvalidInput},
+		{"already confirmed", confirmed.ID.String(), "", http.StatusBadRequest, codeAlreadyConfirmed},
+		{"expired invite", expired.ID.String(), "", http.StatusGone, codeInviteExpired},
+		{"rsvp closed", late.ID.String(), "", http.StatusGone, codeRSVPClosed},
+		{"pending", pending.ID.String(), "", http.StatusNoContent, ""},
+		{"with plus ones", withPlusOnes.ID.String(), `{"plus_ones":2}`, http.StatusNoContent, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodPatch, "/participants/"+tt.participantID+"/confirm", tt.body)
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+
+	if got := store.participants[withPlusOnes.ID]; !got.IsConfirmed || got.PlusOnes != 2 {
+		t.Errorf("participant confirmed %t with %d plus ones, want 2", got.IsConfirmed, got.PlusOnes)
+	}
+}
+
+func TestConcurrentParticipantConfirmation(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	participant := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "bia@example.com"})
+
+	const requests = 20
+	answers := make(chan *httptest.ResponseRecorder, requests)
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			answers <- do(h, http.MethodPatch, "/participants/"+participant.ID.String()+"/confirm", "")
+		}()
+	}
+	wg.Wait()
+	close(answers)
+
+	confirmed := 0
+	for rec := range answers {
+		switch {
+		case rec.Code == http.StatusNoContent:
+			confirmed++
+		case !strings.Contains(rec.Body.String(), codeAlreadyConfirmed):
+			t.Errorf("racing confirmation answered %d %s", rec.Code, rec.Body)
+		}
+	}
+	if confirmed != 1 {
+		t.Errorf("%d of %d racing confirmations succeeded, want 1", confirmed, requests)
+	}
+}
+
+func TestEmptyTripLists(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+
+	tests := []struct {
+		name string
+		path string
+		list string
+	}{
+		{"activities", "/activities", "activities"},
+		{"participants", "/participants", "participants"},
+		{"activities geojson", "/activities.geojson", "features"},
+		{"activities today", "/activities/today", "activities"},
+		{"activity duplicates", "/activities/duplicates", "groups"},
+		{"activity stats", "/activities/stats", "per_day"},
+		{"links", "/links", "links"},
+		{"expenses", "/expenses", "expenses"},
+		{"expense payers", "/expenses/summary", "payers"},
+		{"expense balances", "/expenses/summary", "balances"},
+		{"checklist", "/checklist", "groups"},
+		{"date options", "/date-options", "options"},
+		{"schedule issues", "/schedule/validate", "issues"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(h, http.MethodGet, "/trips/"+trip.ID.String()+tt.path, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got %d %s", rec.Code, rec.Body)
+			}
+
+			var body map[string]json.RawMessage
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %q: %v", rec.Body, err)
+			}
+			if got := string(body[tt.list]); got != "[]" {
+				t.Errorf("%s = %s, want []", tt.list, got)
+			}
+		})
+	}
+}
+
+func TestMapActivities(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	activity := func(title string, day, hour int, pinned bool) pgstore.Activity {
+		return pgstore.Activity{
+			ID:       pgstore.NewID(),
+			Title:    title,
+			OccursAt: pgtype.Timestamptz{Time: time.Date(2030, 7, day, hour, 0, 0, 0, time.UTC), Valid: true},
+			Pinned:   pinned,
+		}
+	}
+	pt, _ := lookupLocale("pt")
+
+	// The activities come in the order of the store, by day in the trip
+	// time zone and pinned first within a day.
+	tests := []struct {
+		name       string
+		activities []pgstore.Activity
+		tz         *time.Location
+		loc        *dateLocale
+		want       [][]string
+		dates      []string
+	}{
+		{"none", nil, time.UTC, nil, [][]string{}, nil},
+		{"one", []pgstore.Activity{activity("Museu", 10, 9, false)}, time.UTC, nil, [][]string{{"Museu"}}, []string{"2030-07-10T00:00:00Z"}},
+		{
+			"grouped by day",
+			[]pgstore.Activity{
+				activity("Jantar", 10, 20, true),
+				activity("Café", 10, 9, false),
+				activity("Museu", 10, 9, false),
+				activity("Almoço", 10, 12, false),
+				activity("Praia", 11, 10, false),
+				activity("Fado", 11, 23, false),
+			},
+			time.UTC,
+			nil,
+			[][]string{{"Jantar", "Café", "Museu", "Almoço"}, {"Praia", "Fado"}},
+			[]string{"2030-07-10T00:00:00Z", "2030-07-11T00:00:00Z"},
+		},
+		{
+			// 01:00 UTC on the 11th is still the evening of the 10th in São
+			// Paulo, three hours behind.
+			"trip time zone",
+			[]pgstore.Activity{
+				activity("Café", 10, 12, false),
+				activity("Jantar", 11, 1, false),
+				activity("Praia", 11, 13, false),
+			},
+			saoPaulo,
+			pt,
+			[][]string{{"Café", "Jantar"}, {"Praia"}},
+			[]string{"2030-07-10T03:00:00Z", "2030-07-11T03:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := mapActivities(tt.activities, tt.tz, tt.loc)
+			if groups == nil {
+				t.Fatal("groups are nil")
+			}
+
+			got := make([][]string, 0, len(groups))
+			var dates []string
+			for _, group := range groups {
+				if group.Date.Location() != time.UTC {
+					t.Errorf("group dated %s, want UTC", group.Date)
+				}
+				dates = append(dates, group.Date.Format(time.RFC3339))
+				local := group.Date.In(tt.tz)
+
+				var titles []string
+				for _, activity := range group.Activities {
+					if at := activity.OccursAt.In(tt.tz); at.Before(local) || !at.Before(local.AddDate(0, 0, 1)) {
+						t.Errorf("%s at %s listed under %s", activity.Title, at, local)
+					}
+					if (tt.loc != nil) != (activity.OccursAtFormatted != "") {
+						t.Errorf("%s formatted as %q", activity.Title, activity.OccursAtFormatted)
+					}
+					titles = append(titles, activity.Title)
+				}
+				if (tt.loc != nil) != (group.DateFormatted != "") {
+					t.Errorf("%s formatted as %q", group.Date, group.DateFormatted)
+				}
+				got = append(got, titles)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("groups %v, want %v", got, tt.want)
+			}
+			if fmt.Sprint(dates) != fmt.Sprint(tt.dates) {
+				t.Errorf("dates %v, want %v", dates, tt.dates)
+			}
+		})
+	}
+
+	// The formatted dates read in the trip time zone.
+	groups := mapActivities(tests[3].activities, saoPaulo, pt)
+	if got, want := groups[0].DateFormatted, "10 de julho de 2030"; got != want {
+		t.Errorf("date formatted as %q, want %q", got, want)
+	}
+	if got, want := groups[0].Activities[1].OccursAtFormatted, "10 de julho de 2030 às 22:00"; got != want {
+		t.Errorf("occurs_at formatted as %q, want %q", got, want)
+	}
+}
+
+func TestGetTripsTripIDActivitiesByDay(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Rio", Timezone: "America/Sao_Paulo"})
+	for _, a := range []struct {
+		title  string
+		day    int
+		hour   int
+		pinned bool
+	}{
+		{"Café", 10, 12, false},
+		// Pinned, and still on the 10th in São Paulo.
+		{"Jantar", 11, 1, true},
+		{"Praia", 11, 13, false},
+		{"Samba", 12, 2, true},
+	} {
+		id := pgstore.NewID()
+		store.activities[id] = pgstore.Activity{
+			ID:       id,
+			TripID:   trip.ID,
+			Title:    a.title,
+			OccursAt: pgtype.Timestamptz{Time: time.Date(2030, 7, a.day, a.hour, 0, 0, 0, time.UTC), Valid: true},
+			Pinned:   a.pinned,
+		}
+	}
+
+	rec := do(h, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", "")
+	var response spec.GetTripActivitiesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); rec.Code != http.StatusOK || err != nil {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+
+	var got [][]string
+	for _, group := range response.Activities {
+		var titles []string
+		for _, activity := range group.Activities {
+			titles = append(titles, activity.Title)
+		}
+		got = append(got, titles)
+	}
+	if want := [][]string{{"Jantar", "Café"}, {"Samba", "Praia"}}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("activities %v, want %v", got, want)
+	}
+}
+
+func BenchmarkMapActivities(b *testing.B) {
+	tz, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// A few thousand activities over a couple of months, a dozen a day.
+	start := time.Date(2030, 7, 10, 0, 0, 0, 0, tz)
+	activities := make([]pgstore.Activity, 5000)
+	for i := range activities {
+		activities[i] = pgstore.Activity{
+			ID:       pgstore.NewID(),
+			Title:    "Atividade",
+			OccursAt: pgtype.Timestamptz{Time: start.Add(time.Duration(i) * 2 * time.Hour), Valid: true},
+		}
+	}
+
+	b.ReportAllocs()
+	for range b.N {
+		mapActivities(activities, tz, nil)
+	}
+}
+
+func TestPostTripsTripIDPublish(t *testing.T) {
+	store, h := newTestServer(t)
+	startsAt := time.Now().AddDate(0, 1, 0)
+	trip := addTrip(store, pgstore.Trip{
+		Destination: "Lisboa",
+		Timezone:    "UTC",
+		IsDraft:     true,
+		Version:     1,
+		StartsAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 5), Valid: true},
+	})
+	path := "/trips/" + trip.ID.String() + "/publish"
+
+	// Racing publishes of the same draft, only one of them goes through and
+	// mails the owner.
+	statuses := make([]int, 8)
+	var wg sync.WaitGroup
+	for i := range statuses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = do(h, http.MethodPost, path, "").Code
+		}()
+	}
+	wg.Wait()
+
+	published := 0
+	for _, status := range statuses {
+		switch status {
+		case http.StatusNoContent:
+			published++
+		case http.StatusBadRequest:
+		default:
+			t.Errorf("publish answered %d", status)
+		}
+	}
+	if published != 1 {
+		t.Errorf("%d publishes went through, want 1", published)
+	}
+
+	confirmations := 0
+	for _, email := range store.emails {
+		if email.Kind == pgstore.EmailKindConfirmTripOwner {
+			confirmations++
+		}
+	}
+	if confirmations != 1 {
+		t.Errorf("%d owner confirmations queued, want 1", confirmations)
+	}
+	if got := store.trips[trip.ID]; got.IsDraft || got.Version != trip.Version+1 {
+		t.Errorf("published trip is draft %t at version %d, want version %d", got.IsDraft, got.Version, trip.Version+1)
+	}
+
+	if status, code := serve(t, h, http.MethodPost, path, ""); status != http.StatusBadRequest || code != codeAlreadyPublished {
+		t.Errorf("publishing again: %d %q, want 400 %q", status, code, codeAlreadyPublished)
+	}
+}
+
+func TestTripLinks(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	path := "/trips/" + trip.ID.String() + "/links"
+
+	tests := []struct {
+		name   string
+		tripID string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown trip", uuid.NewString(), `{"title":"Hotel","url":"https://example.com"}`, http.StatusNotFound, codeNotFound},
+		{"invalid json", trip.ID.String(), `{"title":`, http.StatusBadRequest, codeInvalidJSON},
+		{"missing title", trip.ID.String(), `{"url":"https://example.com"}`, http.StatusBadRequest, codeInvalidInput},
+		{"not a url", trip.ID.String(), `{"title":"Hotel","url":"hotel"}`, http.StatusBadRequest, codeInvalidInput},
+		{"not http", trip.ID.String(), `{"title":"Hotel","url":"ftp://example.com/hotel"}`, http.StatusBadRequest, codeInvalidInput},
+		{"link", trip.ID.String(), `{"title":"Hotel","url":"HTTPS://Example.COM/Reserva"}`, http.StatusCreated, ""},
+		{"by slug", trip.Slug, `{"title":"Voo","url":"https://example.com/voo"}`, http.StatusCreated, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodPost, "/trips/"+tt.tripID+"/links", tt.body)
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+
+	rec := do(h, http.MethodGet, path, "")
+	var list spec.GetLinksResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); rec.Code != http.StatusOK || err != nil {
+		t.Fatalf("GET %s: %d %s", path, rec.Code, rec.Body)
+	}
+	var urls []string
+	for _, link := range list.Links {
+		urls = append(urls, link.URL)
+	}
+	// Listed in the order they were created, with the URL normalized.
+	if want := []string{"https://example.com/Reserva", "https://example.com/voo"}; fmt.Sprint(urls) != fmt.Sprint(want) {
+		t.Errorf("links %v, want %v", urls, want)
+	}
+
+	if status, code := serve(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/links", ""); status != http.StatusNotFound || code != codeNotFound {
+		t.Errorf("links of an unknown trip: %d %q, want 404 %q", status, code, codeNotFound)
+	}
+}
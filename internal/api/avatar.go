@@ -0,0 +1,42 @@
+package api
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Avatars is the Gravatar default image shown for e-mails without a Gravatar,
+// like identicon. The zero value turns avatars off and avatar_url is left out
+// of the responses.
+type Avatars string
+
+// gravatarDefaults are the default image styles Gravatar accepts by name.
+var gravatarDefaults = []string{"404", "mp", "identicon", "monsterid", "wavatar", "retro", "robohash", "blank"}
+
+// NewAvatars checks style is one of the Gravatar default images.
+func NewAvatars(style string) (Avatars, error) {
+	for _, known := range gravatarDefaults {
+		if style == known {
+			return Avatars(style), nil
+		}
+	}
+	return "", fmt.Errorf("api: unknown avatar style %q, expected one of %s", style, strings.Join(gravatarDefaults, ", "))
+}
+
+// url is the Gravatar image of email, empty when avatars are off.
+func (a Avatars) url(email string) string {
+	if a == "" {
+		return ""
+	}
+	return "https://www.gravatar.com/avatar/" + gravatarHash(email) + "?d=" + url.QueryEscape(string(a))
+}
+
+// gravatarHash is the MD5 of the trimmed, lowercased address, the form
+// Gravatar looks images up by.
+func gravatarHash(email string) string {
+	sum := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
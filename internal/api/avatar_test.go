@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+
+	"go.uber.org/zap"
+)
+
+func TestGravatarHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		// The example of the Gravatar documentation.
+		{"documented", "MyEmailAddress@example.com ", "0bc83cb571cd1c50ba6f3e8a78ef1346"},
+		{"normalized", "  myemailaddress@EXAMPLE.com", "0bc83cb571cd1c50ba6f3e8a78ef1346"},
+		{"empty", "", "d41d8cd98f00b204e9800998ecf8427e"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gravatarHash(tt.email); got != tt.want {
+				t.Errorf("gravatarHash(%q) = %s, want %s", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAvatarsURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		avatars Avatars
+		want    string
+	}{
+		{"off", "", ""},
+		{"identicon", "identicon", "https://www.gravatar.com/avatar/0bc83cb571cd1c50ba6f3e8a78ef1346?d=identicon"},
+		{"not found", "404", "https://www.gravatar.com/avatar/0bc83cb571cd1c50ba6f3e8a78ef1346?d=404"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.avatars.url("MyEmailAddress@example.com"); got != tt.want {
+				t.Errorf("url = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAvatars(t *testing.T) {
+	tests := []struct {
+		style   string
+		wantErr bool
+	}{
+		{"identicon", false},
+		{"mp", false},
+		{"404", false},
+		{"", true},
+		{"Identicon", true},
+		{"https://example.com/avatar.png", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			avatars, err := NewAvatars(tt.style)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAvatars(%q) error %v, want error %t", tt.style, err, tt.wantErr)
+			}
+			if err == nil && string(avatars) != tt.style {
+				t.Errorf("NewAvatars(%q) = %q", tt.style, avatars)
+			}
+		})
+	}
+}
+
+func TestParticipantAvatarURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		avatars Avatars
+		want    string
+	}{
+		{"off", "", ""},
+		{"on", "identicon", "https://www.gravatar.com/avatar/0bc83cb571cd1c50ba6f3e8a78ef1346?d=identicon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore()
+			trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+			participant := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "MyEmailAddress@example.com"})
+
+			si := NewAPI(store, zap.NewNop(), testMailer{}, nil, nil, unsubscribe.NewSigner("test"), Settings{
+				MaxTripDays:     30,
+				DefaultTimezone: "UTC",
+				Avatars:         tt.avatars,
+			})
+			h := spec.Handler(&si, spec.WithErrorHandler(ParamError))
+
+			paths := []string{
+				"/participants/" + participant.ID.String() + "/status",
+				"/trips/" + trip.ID.String() + "/participants",
+			}
+			for _, path := range paths {
+				rec := do(h, http.MethodGet, path, "")
+				if rec.Code != http.StatusOK {
+					t.Fatalf("GET %s: %d %s", path, rec.Code, rec.Body)
+				}
+
+				var body struct {
+					AvatarURL    *string `json:"avatar_url"`
+					Participants []struct {
+						AvatarURL *string `json:"avatar_url"`
+					} `json:"participants"`
+				}
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatal(err)
+				}
+				got := body.AvatarURL
+				if len(body.Participants) == 1 {
+					got = body.Participants[0].AvatarURL
+				}
+
+				switch {
+				case tt.want == "" && got != nil:
+					t.Errorf("GET %s: avatar_url %q, want it left out", path, *got)
+				case tt.want != "" && (got == nil || *got != tt.want):
+					t.Errorf("GET %s: avatar_url %v, want %q", path, got, tt.want)
+				}
+			}
+		})
+	}
+}
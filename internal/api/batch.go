@@ -0,0 +1,114 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"journey/internal/api/spec"
+	"net/http"
+	"path"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// batchRoutes are the writes POST /batch may run, as path.Match patterns.
+var batchRoutes = map[string][]string{
+	http.MethodPost: {"/trips", "/trips/*/activities", "/trips/*/links"},
+	http.MethodPut:  {"/trips/*", "/trips/*/links/*"},
+}
+
+func batchAllowed(method, p string) bool {
+	for _, pattern := range batchRoutes[method] {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// batchRecorder keeps what a sub-request handler wrote.
+type batchRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *batchRecorder) Header() http.Header { return rec.header }
+
+func (rec *batchRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(b)
+}
+
+func (rec *batchRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+// PostBatch Run several trip, activity and link writes in one request.
+// (POST /batch)
+func (api ApiServer) PostBatch(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.BatchRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	// Sub-requests go through the generated router so they are decoded and
+	// validated exactly like standalone calls.
+	router := spec.Handler(api, spec.WithErrorHandler(ParamError))
+	response := spec.BatchResponse{Responses: make([]spec.BatchResponseItem, 0, len(body.Requests))}
+	for i, item := range body.Requests {
+		response.Responses = append(response.Responses, api.runBatchItem(r, router, i, item))
+	}
+
+	return spec.PostBatchJSON200Response(response)
+}
+
+func (api ApiServer) runBatchItem(r *http.Request, router http.Handler, i int, item spec.BatchRequestItem) (result spec.BatchResponseItem) {
+	method := item.Method.ToValue()
+	if !batchAllowed(method, item.Path) {
+		return batchError(http.StatusBadRequest, codeInvalidInput, "not allowed in a batch: "+method+" "+item.Path)
+	}
+
+	// A fresh routing context, chi would otherwise keep routing the batch
+	// request itself.
+	ctx := context.WithValue(r.Context(), chi.RouteCtxKey, chi.NewRouteContext())
+	sub, err := http.NewRequestWithContext(ctx, method, item.Path, bytes.NewReader(item.Body))
+	if err != nil {
+		return batchError(http.StatusBadRequest, codeInvalidInput, "invalid path: "+item.Path)
+	}
+	sub.Header.Set("Content-Type", "application/json")
+
+	// A panicking sub-request fails alone, like it would as its own request.
+	defer func() {
+		if rec := recover(); rec != nil {
+			api.log(r.Context()).Error("panic serving batch item", zap.Any("panic", rec), zap.Int("item", i), zap.String("path", item.Path), zap.Stack("stack"))
+			result = batchError(http.StatusInternalServerError, codeInternal, "something went wrong, try again")
+		}
+	}()
+
+	rec := &batchRecorder{header: make(http.Header)}
+	router.ServeHTTP(rec, sub)
+
+	result.Status = rec.status
+	if result.Status == 0 {
+		result.Status = http.StatusOK
+	}
+	if body := bytes.TrimSpace(rec.body.Bytes()); len(body) > 0 {
+		result.Body = body
+	}
+	return result
+}
+
+func batchError(status int, code, message string) spec.BatchResponseItem {
+	rec := &batchRecorder{header: make(http.Header)}
+	writeError(rec, status, code, message)
+	return spec.BatchResponseItem{Status: status, Body: bytes.TrimSpace(rec.body.Bytes())}
+}
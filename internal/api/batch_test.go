@@ -0,0 +1,128 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+)
+
+func TestBatchAllowed(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodPost, "/trips", true},
+		{http.MethodPost, "/trips/t1/activities", true},
+		{http.MethodPost, "/trips/t1/links", true},
+		{http.MethodPut, "/trips/t1", true},
+		{http.MethodPut, "/trips/t1/links/l1", true},
+		{http.MethodGet, "/trips/t1", false},
+		{http.MethodDelete, "/trips/t1", false},
+		{http.MethodPost, "/batch", false},
+		{http.MethodPost, "/trips/t1/participants", false},
+		{http.MethodPost, "/trips/t1/activities/a1", false},
+		{http.MethodPut, "/trips/t1/activities", false},
+		{http.MethodPost, "/admin/emails/e1/requeue", false},
+		{http.MethodPut, "/trips", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			if got := batchAllowed(tt.method, tt.path); got != tt.want {
+				t.Errorf("batchAllowed = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostBatch(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	startsAt := time.Now().AddDate(0, 1, 0).UTC().Format(time.RFC3339)
+	endsAt := time.Now().AddDate(0, 1, 5).UTC().Format(time.RFC3339)
+
+	body := `{"requests":[
+		{"method":"POST","path":"/trips","body":{"destination":"Porto","owner_name":"Ana","owner_email":"ana@example.com","emails_to_invite":[],"starts_at":"` + startsAt + `","ends_at":"` + endsAt + `"}},
+		{"method":"POST","path":"/trips","body":{"destination":"Porto"}},
+		{"method":"PUT","path":"/trips/` + trip.ID.String() + `/activities","body":{}},
+		{"method":"POST","path":"/trips/` + trip.ID.String() + `/links","body":{"title":"Hotel","url":"https://example.com"}}
+	]}`
+
+	rec := do(h, http.MethodPost, "/batch", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d %s", rec.Code, rec.Body)
+	}
+	var response spec.BatchResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every item answers on its own, a failing one doesn't stop the rest.
+	want := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusCreated, ""},
+		{http.StatusBadRequest, codeInvalidInput},
+		{http.StatusBadRequest, codeInvalidInput},
+		{http.StatusCreated, ""},
+	}
+	if len(response.Responses) != len(want) {
+		t.Fatalf("%d responses, want %d", len(response.Responses), len(want))
+	}
+	for i, item := range response.Responses {
+		var failure spec.Error
+		if item.Status >= http.StatusBadRequest {
+			if err := json.Unmarshal(item.Body, &failure); err != nil {
+				t.Fatalf("item %d: error body %s: %v", i, item.Body, err)
+			}
+		}
+		if item.Status != want[i].status || string(failure.Code) != want[i].code {
+			t.Errorf("item %d: got %d %q, want %d %q", i, item.Status, failure.Code, want[i].status, want[i].code)
+		}
+	}
+
+	if len(store.trips) != 2 {
+		t.Errorf("%d trips stored, want the existing one and the created one", len(store.trips))
+	}
+	if len(store.links) != 1 {
+		t.Errorf("%d links stored, want 1", len(store.links))
+	}
+}
+
+func TestPostBatchValidation(t *testing.T) {
+	_, h := newTestServer(t)
+
+	item := `{"method":"POST","path":"/trips","body":{}}`
+	tooMany := `{"requests":[` + item
+	for range 50 {
+		tooMany += "," + item
+	}
+	tooMany += "]}"
+
+	tests := []struct {
+		name string
+		body string
+		code string
+	}{
+		{"invalid json", `{"requests":`, codeInvalidJSON},
+		{"empty", `{"requests":[]}`, codeInvalidInput},
+		{"too many", tooMany, codeInvalidInput},
+		{"missing path", `{"requests":[{"method":"POST"}]}`, codeInvalidInput},
+		{"method not in the spec", `{"requests":[{"method":"DELETE","path":"/trips/t1"}]}`, codeInvalidJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodPost, "/batch", tt.body)
+			if status != http.StatusBadRequest || code != tt.code {
+				t.Fatalf("got %d %q, want 400 %q", status, code, tt.code)
+			}
+		})
+	}
+}
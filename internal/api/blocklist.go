@@ -0,0 +1,64 @@
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DomainBlocklist holds e-mail domains invites are refused for. A nil
+// blocklist blocks nothing.
+type DomainBlocklist map[string]struct{}
+
+// LoadDomainBlocklist reads one domain per line, skipping blank lines and
+// lines starting with #.
+func LoadDomainBlocklist(path string) (DomainBlocklist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to open domain blocklist: %w", err)
+	}
+	defer f.Close()
+
+	blocklist := make(DomainBlocklist)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		blocklist[strings.ToLower(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("api: failed to read domain blocklist: %w", err)
+	}
+
+	return blocklist, nil
+}
+
+// Blocked reports whether the domain of email, or any parent domain of it, is
+// on the list.
+func (b DomainBlocklist) Blocked(email string) bool {
+	if len(b) == 0 {
+		return false
+	}
+
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+
+	domain = strings.ToLower(domain)
+	for {
+		if _, blocked := b[domain]; blocked {
+			return true
+		}
+
+		_, parent, ok := strings.Cut(domain, ".")
+		if !ok {
+			return false
+		}
+		domain = parent
+	}
+}
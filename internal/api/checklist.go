@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// checklistAssignee resolves who a checklist item is assigned to. Only a
+// participant of the trip or its owner can bring an item, neither leaves it
+// unassigned.
+func (api ApiServer) checklistAssignee(ctx context.Context, trip pgstore.Trip, participantID string, owner bool) (pgtype.UUID, *spec.Response) {
+	if participantID == "" {
+		return pgtype.UUID{}, nil
+	}
+	if owner {
+		return pgtype.UUID{}, respondError(http.StatusBadRequest, codeInvalidInput, "assign the item to a participant or to the owner, not both")
+	}
+
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return pgtype.UUID{}, respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	}
+
+	participant, err := api.store.GetParticipant(ctx, id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(ctx).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return pgtype.UUID{}, storeFailure(ctx, err)
+	}
+	if err != nil || participant.TripID != trip.ID {
+		return pgtype.UUID{}, respondError(http.StatusBadRequest, codeInvalidInput, "assignee is not a participant of the trip")
+	}
+	return pgtype.UUID{Bytes: id, Valid: true}, nil
+}
+
+// groupChecklist groups the items, in position order, by who brings them:
+// the owner first, then each participant in the order of their first item,
+// then the unassigned items.
+func groupChecklist(items []pgstore.ChecklistItem) []spec.ChecklistGroup {
+	owner := spec.ChecklistGroup{Owner: true}
+	unassigned := spec.ChecklistGroup{}
+	var participants []spec.ChecklistGroup
+	byParticipant := make(map[uuid.UUID]int)
+
+	for _, item := range items {
+		entry := spec.ChecklistItem{
+			ID:       item.ID.String(),
+			Title:    item.Title,
+			Position: int(item.Position),
+			Done:     item.Done,
+		}
+
+		switch {
+		case item.AssignedToOwner:
+			owner.Items = append(owner.Items, entry)
+		case item.AssigneeID.Valid:
+			id := uuid.UUID(item.AssigneeID.Bytes)
+			i, ok := byParticipant[id]
+			if !ok {
+				i = len(participants)
+				byParticipant[id] = i
+				participants = append(participants, spec.ChecklistGroup{ParticipantID: id.String()})
+			}
+			participants[i].Items = append(participants[i].Items, entry)
+		default:
+			unassigned.Items = append(unassigned.Items, entry)
+		}
+	}
+
+	groups := make([]spec.ChecklistGroup, 0, len(participants)+2)
+	if len(owner.Items) > 0 {
+		groups = append(groups, owner)
+	}
+	groups = append(groups, participants...)
+	if len(unassigned.Items) > 0 {
+		groups = append(groups, unassigned)
+	}
+	return groups
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/logctx"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TimeoutBudget overrides the default request timeout for paths ending in
+// Suffix. A zero Timeout leaves the request without a deadline, for streaming
+// endpoints that are expected to stay open.
+type TimeoutBudget struct {
+	Suffix  string
+	Timeout time.Duration
+}
+
+// APIContext sets up what every handler relies on: a logger tagged with the
+// chi request ID and the route of routes the request goes to, a context
+// bounded by the request timeout so a stuck query can't hold the handler
+// until the client gives up, and recovery of panics into a JSON 500. It must
+// run after middleware.RequestID.
+func APIContext(logger *zap.Logger, routes chi.Routes, timeout time.Duration, budgets ...TimeoutBudget) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fields := append([]zap.Field{zap.String("request_id", middleware.GetReqID(r.Context()))}, routeFields(routes, r)...)
+			reqLogger := logger.With(fields...)
+			ctx := logctx.With(r.Context(), reqLogger)
+
+			if d := requestTimeout(r.URL.Path, timeout, budgets); d > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, d)
+				defer cancel()
+			}
+
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				reqLogger.Error(
+					"panic serving request",
+					zap.Any("panic", rec),
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.Stack("stack"),
+				)
+				body := spec.Error{Code: codeInternal, Message: "something went wrong, try again"}
+				if debugging(ctx) {
+					body.Detail = fmt.Sprint(rec)
+				}
+				respondJSON(w, http.StatusInternalServerError, body)
+			}()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// routeFields names the route pattern r matches, and its trip when the route
+// has one. Middleware runs before chi routes the request, so the route is
+// matched ahead here.
+func routeFields(routes chi.Routes, r *http.Request) []zap.Field {
+	rctx := chi.NewRouteContext()
+	if routes == nil || !routes.Match(rctx, r.Method, r.URL.Path) {
+		return nil
+	}
+
+	fields := []zap.Field{zap.String("route", rctx.RoutePattern())}
+	switch trip := rctx.URLParam("tripId"); {
+	case trip == "":
+	case uuid.Validate(trip) == nil:
+		fields = append(fields, zap.String("trip_id", trip))
+	default:
+		fields = append(fields, zap.String("trip_slug", trip))
+	}
+	return fields
+}
+
+func requestTimeout(path string, timeout time.Duration, budgets []TimeoutBudget) time.Duration {
+	for _, budget := range budgets {
+		if strings.HasSuffix(path, budget.Suffix) {
+			return budget.Timeout
+		}
+	}
+	return timeout
+}
+
+// log returns the request scoped logger set up by APIContext, falling back
+// to the server logger outside of it.
+func (api ApiServer) log(ctx context.Context) *zap.Logger {
+	return logctx.From(ctx, api.logger)
+}
@@ -0,0 +1,19 @@
+package api
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// coordinate is the column value of an optional latitude or longitude.
+func coordinate(v *float64) pgtype.Float8 {
+	if v == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *v, Valid: true}
+}
+
+// coordinateOrNil is the response value of a nullable latitude or longitude.
+func coordinateOrNil(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
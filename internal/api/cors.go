@@ -0,0 +1,119 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// corsMethods are the methods a preflight request may ask for, every method
+// the API routes use.
+const corsMethods = "GET, POST, PUT, PATCH, DELETE"
+
+// CORSPolicy configures the cross-origin access of browser clients. No
+// origins keeps CORS off, "*" allows any origin but can't be combined with
+// credentials.
+type CORSPolicy struct {
+	Origins []string
+	// Credentials lets browsers send cookies and the Authorization header
+	// along, and read the answers.
+	Credentials bool
+	// Headers are the request headers a preflight allows.
+	Headers []string
+	// Expose are the response headers scripts may read besides the
+	// CORS-safelisted ones, like ETag.
+	Expose []string
+}
+
+// Equal reports whether both policies allow the same.
+func (p CORSPolicy) Equal(other CORSPolicy) bool {
+	return p.Credentials == other.Credentials &&
+		slices.Equal(p.Origins, other.Origins) &&
+		slices.Equal(p.Headers, other.Headers) &&
+		slices.Equal(p.Expose, other.Expose)
+}
+
+// ParseCORSList splits a comma separated list of origins or headers,
+// dropping the blanks.
+func ParseCORSList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// CORS answers preflight requests and tags the answers to allowed origins.
+// The CORS spec forbids the wildcard origin on credentialed requests, so
+// with credentials allowed a "*" origin is dropped and only the listed
+// origins are served. It runs before AdminOnly, preflights carry no token.
+func CORS(policy CORSPolicy, logger *zap.Logger) func(http.Handler) http.Handler {
+	origins := make(map[string]bool, len(policy.Origins))
+	var wildcard bool
+	for _, origin := range policy.Origins {
+		if origin == "*" {
+			wildcard = true
+			continue
+		}
+		origins[strings.TrimSuffix(origin, "/")] = true
+	}
+	if wildcard && policy.Credentials {
+		logger.Warn("wildcard CORS origin ignored, it can't be used with credentials", zap.Strings("origins", policy.Origins))
+		wildcard = false
+	}
+	if policy.Credentials && (slices.Contains(policy.Headers, "*") || slices.Contains(policy.Expose, "*")) {
+		logger.Warn("wildcard CORS headers are taken literally with credentials, list the headers instead")
+	}
+
+	headers := strings.Join(policy.Headers, ", ")
+	expose := strings.Join(policy.Expose, ", ")
+
+	return func(next http.Handler) http.Handler {
+		if !wildcard && len(origins) == 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+			h := w.Header()
+			if !wildcard {
+				h.Add("Vary", "Origin")
+			}
+
+			switch {
+			case origin == "":
+			case wildcard:
+				h.Set("Access-Control-Allow-Origin", "*")
+			case origins[origin]:
+				h.Set("Access-Control-Allow-Origin", origin)
+				if policy.Credentials {
+					h.Set("Access-Control-Allow-Credentials", "true")
+				}
+			default:
+				origin = ""
+			}
+
+			if origin != "" && preflight {
+				h.Set("Access-Control-Allow-Methods", corsMethods)
+				if headers != "" {
+					h.Set("Access-Control-Allow-Headers", headers)
+				}
+			}
+			if origin != "" && !preflight && expose != "" {
+				h.Set("Access-Control-Expose-Headers", expose)
+			}
+
+			if preflight {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
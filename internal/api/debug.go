@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"net/http"
+)
+
+type debugKey struct{}
+
+// DebugErrors lets a request ask, with ?debug=true, for the underlying error
+// in the detail of its error responses. Outside of enabled the parameter is
+// ignored, production must never hand out driver or query errors. It runs
+// before APIContext, so recovered panics carry their value too.
+func DebugErrors(enabled bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !enabled {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("debug") == "true" {
+				r = r.WithContext(context.WithValue(r.Context(), debugKey{}, true))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func debugging(ctx context.Context) bool {
+	debug, _ := ctx.Value(debugKey{}).(bool)
+	return debug
+}
+
+// respondErrorCause is respondError for failures with an underlying error,
+// shown as the detail of debug responses. A not found from the store then
+// reads "no rows in result set", telling it apart from the checks of the
+// handler, which answer without a cause.
+func respondErrorCause(ctx context.Context, status int, code, message string, err error) *spec.Response {
+	body := spec.Error{Code: code, Message: message}
+	if err != nil && debugging(ctx) {
+		body.Detail = err.Error()
+	}
+	return spec.ErrorResponse(status, body)
+}
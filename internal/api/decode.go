@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// decodeJSON reads the request body into dst with every string value
+// normalized first, so values are stored and matched the same way however
+// clients sent them. Strings are cleaned before dst is filled so types that
+// validate while unmarshalling, like openapi_types.Email, see them clean too.
+func decodeJSON(r *http.Request, dst any) error {
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+
+	var raw any
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	normalized, err := json.Marshal(normalizeStrings(raw))
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(bytes.NewReader(normalized)).Decode(dst)
+}
+
+// normalizeStrings rewrites every string value of a decoded JSON document,
+// leaving object keys alone.
+func normalizeStrings(v any) any {
+	switch v := v.(type) {
+	case string:
+		return normalizeString(v)
+	case map[string]any:
+		for key, elem := range v {
+			v[key] = normalizeStrings(elem)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = normalizeStrings(elem)
+		}
+	}
+	return v
+}
+
+// normalizeString composes the text to NFC, turns each run of control
+// characters and whitespace into a single space, and trims the ends.
+func normalizeString(s string) string {
+	s = norm.NFC.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	pending := false
+	for _, r := range s {
+		if unicode.IsControl(r) || unicode.IsSpace(r) {
+			pending = b.Len() > 0
+			continue
+		}
+		if pending {
+			b.WriteByte(' ')
+			pending = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
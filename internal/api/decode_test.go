@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"journey/internal/api/spec"
+)
+
+func TestNormalizeString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"clean", "Lisboa", "Lisboa"},
+		{"trailing space and newline", " Lisboa \n", "Lisboa"},
+		{"embedded newline", "Museu\ndo Prado", "Museu do Prado"},
+		{"tabs and controls", "ana@example.com\t\x00", "ana@example.com"},
+		{"runs collapse", "São  \t\r\n Paulo", "São Paulo"},
+		{"decomposed to nfc", "Sa\u0303o Paulo", "S\u00e3o Paulo"},
+		{"only whitespace", " \n\t ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeString(tt.in); got != tt.want {
+				t.Errorf("normalizeString(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	var body struct {
+		Title string            `json:"title"`
+		Tags  []string          `json:"tags"`
+		Meta  map[string]string `json:"meta"`
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"title":" Museu \n","tags":["\tarte"],"meta":{" key ":" value\n"}}`))
+	if err := decodeJSON(r, &body); err != nil {
+		t.Fatal(err)
+	}
+
+	if body.Title != "Museu" || body.Tags[0] != "arte" || body.Meta[" key "] != "value" {
+		t.Errorf("decoded %+v, want trimmed values and keys left alone", body)
+	}
+}
+
+func TestNormalizedTripRoundTrip(t *testing.T) {
+	_, h := newTestServer(t)
+
+	rec := do(h, http.MethodPost, "/trips", `{"destination":" Lisboa \n","owner_name":" Ana\t","owner_email":" ana@example.com ","emails_to_invite":[],"draft":true}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create: %d %s", rec.Code, rec.Body)
+	}
+	var created spec.CreateTripResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+
+	rec = do(h, http.MethodGet, "/trips/"+created.TripID, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get: %d %s", rec.Code, rec.Body)
+	}
+	var got spec.GetTripDetailsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Trip.Destination != "Lisboa" {
+		t.Errorf("destination %q, want %q", got.Trip.Destination, "Lisboa")
+	}
+}
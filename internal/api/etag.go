@@ -0,0 +1,42 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"journey/internal/api/spec"
+	"net/http"
+	"strings"
+)
+
+// withETag tags a read with a weak ETag hashed from its JSON body, answering
+// 304 without a body when the client's If-None-Match already holds it.
+// Hashing the representation keeps the tag right on every mutation path
+// without tracking update times.
+func withETag[T any](w http.ResponseWriter, r *http.Request, body T, ok func(T) *spec.Response) *spec.Response {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return ok(body)
+	}
+
+	sum := sha256.Sum256(payload)
+	tag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", tag)
+
+	if etagMatches(r.Header.Get("If-None-Match"), tag) {
+		return &spec.Response{Code: http.StatusNotModified}
+	}
+
+	return ok(body)
+}
+
+// etagMatches applies the weak comparison If-None-Match calls for.
+func etagMatches(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(tag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestEtagMatches(t *testing.T) {
+	const tag = `W/"abc"`
+
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{"empty", "", false},
+		{"same", `W/"abc"`, true},
+		{"strong form", `"abc"`, true},
+		{"other", `W/"def"`, false},
+		{"in a list", `W/"def", W/"abc"`, true},
+		{"any", "*", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.header, tag); got != tt.want {
+				t.Errorf("etagMatches(%q) = %t, want %t", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+// get runs a conditional GET, returning the status and the ETag answered.
+func get(t *testing.T, h http.Handler, path, ifNoneMatch string) (int, string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code == http.StatusNotModified && rec.Body.Len() > 0 {
+		t.Errorf("GET %s: 304 with a body", path)
+	}
+	return rec.Code, rec.Header().Get("ETag")
+}
+
+func TestETagRevalidation(t *testing.T) {
+	store, h := newTestServer(t)
+	startsAt := time.Now().AddDate(0, 1, 0).UTC().Truncate(time.Hour)
+	trip := addTrip(store, pgstore.Trip{
+		Destination: "Lisboa",
+		Timezone:    "UTC",
+		StartsAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 3), Valid: true},
+	})
+
+	tests := []struct {
+		name   string
+		path   string
+		change func(t *testing.T)
+	}{
+		{
+			name: "trip",
+			path: "/trips/" + trip.ID.String(),
+			change: func(t *testing.T) {
+				changed := store.trips[trip.ID]
+				changed.Destination = "Porto"
+				store.trips[trip.ID] = changed
+			},
+		},
+		{
+			name: "activities",
+			path: "/trips/" + trip.ID.String() + "/activities",
+			change: func(t *testing.T) {
+				body := `{"title":"Museu","occurs_at":"` + startsAt.Add(time.Hour).Format(time.RFC3339) + `"}`
+				if status, code := serve(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body); status != http.StatusCreated {
+					t.Fatalf("create activity: %d %q", status, code)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, tag := get(t, h, tt.path, "")
+			if status != http.StatusOK || !strings.HasPrefix(tag, `W/"`) {
+				t.Fatalf("first read: %d with ETag %q", status, tag)
+			}
+
+			if status, _ := get(t, h, tt.path, tag); status != http.StatusNotModified {
+				t.Fatalf("unchanged read: %d, want 304", status)
+			}
+
+			tt.change(t)
+
+			status, changed := get(t, h, tt.path, tag)
+			if status != http.StatusOK || changed == tag {
+				t.Fatalf("read after change: %d with ETag %q, want 200 with a new one", status, changed)
+			}
+		})
+	}
+}
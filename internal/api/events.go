@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"journey/internal/api/spec"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	eventActivityCreated = "activity.created"
+	eventActivityUpdated = "activity.updated"
+	eventActivityDeleted = "activity.deleted"
+)
+
+// tripEventsKeepAlive is how often an idle stream gets a comment, so proxies
+// don't drop the connection.
+const tripEventsKeepAlive = 15 * time.Second
+
+// tripEventActivity is the activity an event is about. Updates only carry
+// the ID and the fields that changed, deletions only the ID.
+type tripEventActivity struct {
+	ID              string     `json:"id"`
+	Title           string     `json:"title,omitempty"`
+	OccursAt        *time.Time `json:"occurs_at,omitempty"`
+	DurationMinutes *int       `json:"duration_minutes,omitempty"`
+	Pinned          *bool      `json:"pinned,omitempty"`
+}
+
+type tripEvent struct {
+	Type     string            `json:"type"`
+	TripID   string            `json:"trip_id"`
+	Activity tripEventActivity `json:"activity"`
+}
+
+// tripEvents fans trip changes out to the streams open in this process.
+// Events are best effort: a stream that can't keep up misses them rather
+// than slowing down the handler that published.
+type tripEvents struct {
+	mu        sync.Mutex
+	subs      map[uuid.UUID]map[chan tripEvent]struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newTripEvents() *tripEvents {
+	return &tripEvents{
+		subs: make(map[uuid.UUID]map[chan tripEvent]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+func (e *tripEvents) subscribe(tripID uuid.UUID) (<-chan tripEvent, func()) {
+	ch := make(chan tripEvent, 16)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.subs[tripID] == nil {
+		e.subs[tripID] = make(map[chan tripEvent]struct{})
+	}
+	e.subs[tripID][ch] = struct{}{}
+
+	return ch, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		delete(e.subs[tripID], ch)
+		if len(e.subs[tripID]) == 0 {
+			delete(e.subs, tripID)
+		}
+	}
+}
+
+func (e *tripEvents) publish(tripID uuid.UUID, event tripEvent) {
+	event.TripID = tripID.String()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for ch := range e.subs[tripID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// CloseEvents ends every open event stream, the server waits for handlers
+// on shutdown and streams would otherwise hold it until the timeout.
+func (api ApiServer) CloseEvents() {
+	api.events.closeOnce.Do(func() { close(api.events.done) })
+}
+
+// GetTripsTripIDEvents Stream the changes to a trip as server-sent events.
+// (GET /trips/{tripId}/events)
+func (api ApiServer) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	// The server write timeout is meant for regular requests, it would cut
+	// the stream after a few seconds.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		api.log(r.Context()).Error("failed to clear write deadline", zap.Error(err))
+		return respondError(http.StatusInternalServerError, codeInternal, "streaming not supported")
+	}
+
+	events, unsubscribe := api.events.subscribe(trip.ID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	keepAlive := time.NewTicker(tripEventsKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-api.events.done:
+			return nil
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				api.log(r.Context()).Error("failed to encode trip event", zap.Error(err))
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+)
+
+var errMixedCurrencies = errors.New("trip expenses are in more than one currency")
+
+// summarizeExpenses totals the expenses per payer and splits the sum equally
+// between the confirmed participants, in their given order. Amounts stay in
+// minor units, so the remainder of the division goes one unit each to the
+// first participants and the shares always add up to the total.
+func summarizeExpenses(totals []pgstore.GetTripExpenseTotalsRow, participants []pgstore.Participant) (spec.GetTripExpenseSummaryResponse, error) {
+	summary := spec.GetTripExpenseSummaryResponse{
+		Payers:   make([]spec.ExpenseSummaryPayer, 0, len(totals)),
+		Balances: []spec.ExpenseSummaryBalance{},
+	}
+
+	paid := make(map[string]int64, len(totals))
+	for _, row := range totals {
+		if summary.Currency != "" && row.Currency != summary.Currency {
+			return spec.GetTripExpenseSummaryResponse{}, errMixedCurrencies
+		}
+		summary.Currency = row.Currency
+		summary.Total += row.Total
+		paid[row.PayerID.String()] += row.Total
+		summary.Payers = append(summary.Payers, spec.ExpenseSummaryPayer{
+			ParticipantID: row.PayerID.String(),
+			Total:         row.Total,
+		})
+	}
+
+	var confirmed []pgstore.Participant
+	for _, participant := range participants {
+		if participant.IsConfirmed {
+			confirmed = append(confirmed, participant)
+		}
+	}
+	if len(confirmed) == 0 {
+		return summary, nil
+	}
+
+	share := summary.Total / int64(len(confirmed))
+	remainder := summary.Total % int64(len(confirmed))
+	for i, participant := range confirmed {
+		id := participant.ID.String()
+		owed := share
+		if int64(i) < remainder {
+			owed++
+		}
+		summary.Balances = append(summary.Balances, spec.ExpenseSummaryBalance{
+			ParticipantID: id,
+			Paid:          paid[id],
+			Share:         owed,
+			Balance:       paid[id] - owed,
+		})
+	}
+	return summary, nil
+}
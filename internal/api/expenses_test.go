@@ -0,0 +1,257 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestSummarizeExpenses(t *testing.T) {
+	ana := pgstore.Participant{ID: pgstore.NewID(), Email: "ana@example.com", IsConfirmed: true}
+	bia := pgstore.Participant{ID: pgstore.NewID(), Email: "bia@example.com", IsConfirmed: true}
+	caio := pgstore.Participant{ID: pgstore.NewID(), Email: "caio@example.com", IsConfirmed: true}
+	pending := pgstore.Participant{ID: pgstore.NewID(), Email: "duda@example.com"}
+
+	total := func(payer pgstore.Participant, currency string, amount int64) pgstore.GetTripExpenseTotalsRow {
+		return pgstore.GetTripExpenseTotalsRow{PayerID: payer.ID, Currency: currency, Total: amount}
+	}
+	balance := func(participant pgstore.Participant, paid, share int64) spec.ExpenseSummaryBalance {
+		return spec.ExpenseSummaryBalance{ParticipantID: participant.ID.String(), Paid: paid, Share: share, Balance: paid - share}
+	}
+
+	tests := []struct {
+		name         string
+		totals       []pgstore.GetTripExpenseTotalsRow
+		participants []pgstore.Participant
+		total        int64
+		balances     []spec.ExpenseSummaryBalance
+		wantErr      error
+	}{
+		{
+			name:         "no expenses",
+			participants: []pgstore.Participant{ana, bia},
+			balances:     []spec.ExpenseSummaryBalance{balance(ana, 0, 0), balance(bia, 0, 0)},
+		},
+		{
+			name:         "nobody confirmed",
+			totals:       []pgstore.GetTripExpenseTotalsRow{total(ana, "BRL", 1000)},
+			participants: []pgstore.Participant{pending},
+			total:        1000,
+			balances:     []spec.ExpenseSummaryBalance{},
+		},
+		{
+			name:         "even split",
+			totals:       []pgstore.GetTripExpenseTotalsRow{total(ana, "BRL", 3000), total(bia, "BRL", 1000)},
+			participants: []pgstore.Participant{ana, bia, pending},
+			total:        4000,
+			balances:     []spec.ExpenseSummaryBalance{balance(ana, 3000, 2000), balance(bia, 1000, 2000)},
+		},
+		{
+			name:         "remainder to the first",
+			totals:       []pgstore.GetTripExpenseTotalsRow{total(caio, "EUR", 1001)},
+			participants: []pgstore.Participant{ana, bia, caio},
+			total:        1001,
+			balances:     []spec.ExpenseSummaryBalance{balance(ana, 0, 334), balance(bia, 0, 334), balance(caio, 1001, 333)},
+		},
+		{
+			name:         "mixed currencies",
+			totals:       []pgstore.GetTripExpenseTotalsRow{total(ana, "BRL", 1000), total(bia, "EUR", 1000)},
+			participants: []pgstore.Participant{ana, bia},
+			wantErr:      errMixedCurrencies,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, err := summarizeExpenses(tt.totals, tt.participants)
+			if err != tt.wantErr {
+				t.Fatalf("error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if summary.Total != tt.total {
+				t.Errorf("total %d, want %d", summary.Total, tt.total)
+			}
+			if len(summary.Payers) != len(tt.totals) {
+				t.Errorf("%d payers, want %d", len(summary.Payers), len(tt.totals))
+			}
+			if fmt.Sprint(summary.Balances) != fmt.Sprint(tt.balances) {
+				t.Errorf("balances %v, want %v", summary.Balances, tt.balances)
+			}
+
+			var shares int64
+			for _, b := range summary.Balances {
+				shares += b.Share
+			}
+			if len(summary.Balances) > 0 && shares != summary.Total {
+				t.Errorf("shares add up to %d, want %d", shares, summary.Total)
+			}
+		})
+	}
+}
+
+func TestPostTripsTripIDExpenses(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	other := addTrip(store, pgstore.Trip{Destination: "Porto", Timezone: "UTC"})
+	payer := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "ana@example.com", IsConfirmed: true})
+	stranger := addParticipant(store, pgstore.Participant{TripID: other.ID, Email: "bia@example.com"})
+	activity := pgstore.Activity{ID: pgstore.NewID(), TripID: trip.ID, Title: "Museu", OccursAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}}
+	store.activities[activity.ID] = activity
+	strangerActivity := pgstore.Activity{ID: pgstore.NewID(), TripID: other.ID, Title: "Ribeira", OccursAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}}
+	store.activities[strangerActivity.ID] = strangerActivity
+
+	expense := func(amount, currency string, payerID uuid.UUID, activityID string) string {
+		body := `{"description":"Jantar","amount":` + amount + `,"currency":"` + currency + `","payer_id":"` + payerID.String() + `"`
+		if activityID != "" {
+			body += `,"activity_id":"` + activityID + `"`
+		}
+		return body + "}"
+	}
+
+	// Run in order, the first expense fixes the currency of the trip.
+	tests := []struct {
+		name   string
+		tripID string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown trip", uuid.NewString(), expense("1000", "BRL", payer.ID, ""), http.StatusNotFound, codeNotFound},
+		{"invalid json", trip.ID.String(), `{"amount":`, http.StatusBadRequest, codeInvalidJSON},
+		{"fractional amount", trip.ID.String(), expense("10.50", "BRL", payer.ID, ""), http.StatusBadRequest, codeInvalidJSON},
+		{"zero amount", trip.ID.String(), expense("0", "BRL", payer.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"negative amount", trip.ID.String(), expense("-100", "BRL", payer.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"amount over a billion", trip.ID.String(), expense("100000000001", "BRL", payer.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"amount past int64", trip.ID.String(), expense("9223372036854775808", "BRL", payer.ID, ""), http.StatusBadRequest, codeInvalidJSON},
+		{"unknown currency", trip.ID.String(), expense("1000", "XYZ", payer.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"lowercase currency", trip.ID.String(), expense("1000", "brl", payer.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"unknown payer", trip.ID.String(), expense("1000", "BRL", uuid.New(), ""), http.StatusBadRequest, codeInvalidInput},
+		{"payer of another trip", trip.ID.String(), expense("1000", "BRL", stranger.ID, ""), http.StatusBadRequest, codeInvalidInput},
+		{"activity of another trip", trip.ID.String(), expense("1000", "BRL", payer.ID, strangerActivity.ID.String()), http.StatusBadRequest, codeInvalidInput},
+		{"expense", trip.ID.String(), expense("1000", "BRL", payer.ID, ""), http.StatusCreated, ""},
+		{"with activity", trip.ID.String(), expense("2500", "BRL", payer.ID, activity.ID.String()), http.StatusCreated, ""},
+		{"other currency", trip.ID.String(), expense("1000", "EUR", payer.ID, ""), http.StatusConflict, codeCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodPost, "/trips/"+tt.tripID+"/expenses", tt.body)
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+
+	if len(store.expenses) != 2 {
+		t.Errorf("%d expenses stored, want 2", len(store.expenses))
+	}
+}
+
+func TestTripExpenses(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	ana := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "ana@example.com", IsConfirmed: true})
+	bia := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "bia@example.com", IsConfirmed: true})
+	path := "/trips/" + trip.ID.String() + "/expenses"
+
+	var ids []string
+	for _, body := range []string{
+		`{"description":"Hotel","amount":9000,"currency":"EUR","payer_id":"` + ana.ID.String() + `"}`,
+		`{"description":"Jantar","amount":3001,"currency":"EUR","payer_id":"` + bia.ID.String() + `"}`,
+	} {
+		rec := do(h, http.MethodPost, path, body)
+		var created spec.CreateExpenseResponse
+		if rec.Code != http.StatusCreated || json.Unmarshal(rec.Body.Bytes(), &created) != nil {
+			t.Fatalf("POST %s: %d %s", path, rec.Code, rec.Body)
+		}
+		ids = append(ids, created.ExpenseID)
+	}
+
+	rec := do(h, http.MethodGet, path, "")
+	var list spec.GetTripExpensesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); rec.Code != http.StatusOK || err != nil {
+		t.Fatalf("GET %s: %d %s", path, rec.Code, rec.Body)
+	}
+	if len(list.Expenses) != 2 {
+		t.Fatalf("%d expenses listed, want 2", len(list.Expenses))
+	}
+
+	rec = do(h, http.MethodGet, path+"/summary", "")
+	var summary spec.GetTripExpenseSummaryResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &summary); rec.Code != http.StatusOK || err != nil {
+		t.Fatalf("GET %s/summary: %d %s", path, rec.Code, rec.Body)
+	}
+	if summary.Currency != "EUR" || summary.Total != 12001 {
+		t.Errorf("summary of %d %s, want 12001 EUR", summary.Total, summary.Currency)
+	}
+	want := []spec.ExpenseSummaryBalance{
+		{ParticipantID: ana.ID.String(), Paid: 9000, Share: 6001, Balance: 2999},
+		{ParticipantID: bia.ID.String(), Paid: 3001, Share: 6000, Balance: -2999},
+	}
+	if fmt.Sprint(summary.Balances) != fmt.Sprint(want) {
+		t.Errorf("balances %v, want %v", summary.Balances, want)
+	}
+
+	deletes := []struct {
+		name      string
+		expenseID string
+		status    int
+		code      string
+	}{
+		{"invalid id", "not-a-uuid", http.StatusBadRequest, codeInvalidID},
+		{"unknown", uuid.NewString(), http.StatusNotFound, codeNotFound},
+		{"expense", ids[0], http.StatusNoContent, ""},
+		{"already deleted", ids[0], http.StatusNotFound, codeNotFound},
+	}
+	for _, tt := range deletes {
+		t.Run("delete "+tt.name, func(t *testing.T) {
+			status, code := serve(t, h, http.MethodDelete, path+"/"+tt.expenseID, "")
+			if status != tt.status || code != tt.code {
+				t.Fatalf("got %d %q, want %d %q", status, code, tt.status, tt.code)
+			}
+		})
+	}
+
+	if len(store.expenses) != 1 {
+		t.Errorf("%d expenses left, want 1", len(store.expenses))
+	}
+}
+
+func TestRemoveExpensePayer(t *testing.T) {
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	ana := addParticipant(store, pgstore.Participant{TripID: trip.ID, Email: "ana@example.com", IsConfirmed: true})
+	path := "/trips/" + trip.ID.String()
+
+	rec := do(h, http.MethodPost, path+"/expenses", `{"description":"Hotel","amount":9000,"currency":"EUR","payer_id":"`+ana.ID.String()+`"}`)
+	var created spec.CreateExpenseResponse
+	if rec.Code != http.StatusCreated || json.Unmarshal(rec.Body.Bytes(), &created) != nil {
+		t.Fatalf("POST %s/expenses: %d %s", path, rec.Code, rec.Body)
+	}
+
+	// The expense keeps its payer, the split would lose it otherwise.
+	if status, code := serve(t, h, http.MethodDelete, path+"/participants/"+ana.ID.String(), ""); status != http.StatusConflict || code != codeExpensePayer {
+		t.Fatalf("removing the payer: %d %q, want 409 %q", status, code, codeExpensePayer)
+	}
+	if _, ok := store.participants[ana.ID]; !ok || len(store.expenses) != 1 {
+		t.Fatalf("payer removed %t, %d expenses left", !ok, len(store.expenses))
+	}
+
+	if status, code := serve(t, h, http.MethodDelete, path+"/expenses/"+created.ExpenseID, ""); status != http.StatusNoContent {
+		t.Fatalf("deleting the expense: %d %q", status, code)
+	}
+	if status, code := serve(t, h, http.MethodDelete, path+"/participants/"+ana.ID.String(), ""); status != http.StatusNoContent {
+		t.Errorf("removing the participant without expenses: %d %q, want 204", status, code)
+	}
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"journey/internal/api/spec"
+	"net/http"
+	"strings"
+)
+
+// tripFieldNames are the trip fields ?fields= can select, the JSON names of
+// GetTripDetailsResponseTripObj.
+var tripFieldNames = []string{
+	"id",
+	"destination",
+	"starts_at",
+	"ends_at",
+	"starts_at_formatted",
+	"ends_at_formatted",
+	"is_confirmed",
+	"is_draft",
+	"slug",
+	"version",
+	"rsvp_deadline",
+	"timezone",
+}
+
+// tripFields is the sparse fieldset a client asked for, nil for the whole
+// trip.
+type tripFields map[string]bool
+
+func parseTripFields(raw *string) (tripFields, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	fields := make(tripFields)
+	for _, name := range strings.Split(*raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !isTripField(name) {
+			return nil, fmt.Errorf("unknown field %q, valid fields are %s", name, strings.Join(tripFieldNames, ", "))
+		}
+		fields[name] = true
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("fields must list at least one of %s", strings.Join(tripFieldNames, ", "))
+	}
+	return fields, nil
+}
+
+func isTripField(name string) bool {
+	for _, field := range tripFieldNames {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonOK answers 200 with a projected body.
+func jsonOK(body map[string]any) *spec.Response {
+	return spec.JSONResponse(http.StatusOK, body)
+}
+
+// project trims trip to the selected fields. Going through the JSON form
+// keeps the names and formats identical to the full response.
+func (f tripFields) project(trip spec.GetTripDetailsResponseTripObj) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(trip)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	for name := range all {
+		if !f[name] {
+			delete(all, name)
+		}
+	}
+	return all, nil
+}
+
+func (f tripFields) projectAll(trips []spec.GetTripDetailsResponseTripObj) ([]map[string]json.RawMessage, error) {
+	projected := make([]map[string]json.RawMessage, len(trips))
+	for i, trip := range trips {
+		var err error
+		if projected[i], err = f.project(trip); err != nil {
+			return nil, err
+		}
+	}
+	return projected, nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string                    `json:"type"`
+	Geometry   geoJSONPoint              `json:"geometry"`
+	Properties geoJSONActivityProperties `json:"properties"`
+}
+
+// geoJSONPoint holds its coordinates as longitude then latitude, the GeoJSON
+// order.
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type geoJSONActivityProperties struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	OccursAt        time.Time `json:"occurs_at"`
+	DurationMinutes *int      `json:"duration_minutes,omitempty"`
+}
+
+// activityFeatures maps the activities with coordinates to Point features,
+// in the order they come. The result is never nil, a trip without located
+// activities is answered with an empty collection.
+func activityFeatures(activities []pgstore.Activity) geoJSONFeatureCollection {
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	for _, activity := range activities {
+		if !activity.Latitude.Valid || !activity.Longitude.Valid {
+			continue
+		}
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONPoint{
+				Type:        "Point",
+				Coordinates: [2]float64{activity.Longitude.Float64, activity.Latitude.Float64},
+			},
+			Properties: geoJSONActivityProperties{
+				ID:              activity.ID.String(),
+				Title:           activity.Title,
+				OccursAt:        utc(activity.OccursAt),
+				DurationMinutes: durationMinutesOrNil(activity.DurationMinutes),
+			},
+		})
+	}
+	return collection
+}
+
+// GetTripsTripIDActivitiesGeojson Get the activities of a trip as a GeoJSON feature collection.
+// (GET /trips/{tripId}/activities.geojson)
+func (api ApiServer) GetTripsTripIDActivitiesGeojson(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip, err := api.existingTrip(r.Context(), tripID)
+	if err != nil {
+		return api.existingTripFailure(r.Context(), err)
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: trip.ID})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("tripID", tripID))
+		return storeFailure(r.Context(), err)
+	}
+
+	// The generated responses always go out as application/json, map
+	// clients pick the format by its media type.
+	body, err := json.Marshal(activityFeatures(activities))
+	if err != nil {
+		api.log(r.Context()).Error("failed to encode activities", zap.Error(err), zap.String("tripID", tripID))
+		return respondError(http.StatusInternalServerError, codeInternal, "something went wrong, try again")
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		api.log(r.Context()).Debug("failed to write activities", zap.Error(err))
+	}
+	return nil
+}
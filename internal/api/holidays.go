@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"journey/internal/api/spec"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Holidays maps a place, a country code, name or any alias listed for it, to
+// the public holidays of that country. A nil value knows no holidays.
+type Holidays map[string][]holiday
+
+type holiday struct {
+	date time.Time
+	name string
+}
+
+// holidaysFile is the layout of the holidays file, keyed by country code:
+//
+//	{"BR": {"names": ["Brasil", "Brazil"], "holidays": [{"date": "2025-12-25", "name": "Natal"}]}}
+type holidaysFile map[string]struct {
+	Names    []string `json:"names"`
+	Holidays []struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+	} `json:"holidays"`
+}
+
+// LoadHolidays reads the holidays file. Trip creation only reads it from
+// memory, so it works offline and never waits on a calendar service.
+func LoadHolidays(path string) (Holidays, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to open holidays: %w", err)
+	}
+
+	var file holidaysFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("api: failed to read holidays: %w", err)
+	}
+
+	holidays := make(Holidays)
+	for code, country := range file {
+		list := make([]holiday, 0, len(country.Holidays))
+		for _, h := range country.Holidays {
+			date, err := time.Parse(time.DateOnly, h.Date)
+			if err != nil {
+				return nil, fmt.Errorf("api: invalid holiday date %q for %s: %w", h.Date, code, err)
+			}
+			list = append(list, holiday{date, h.Name})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].date.Before(list[j].date) })
+
+		for _, place := range append([]string{code}, country.Names...) {
+			holidays[placeKey(place)] = list
+		}
+	}
+
+	return holidays, nil
+}
+
+// During lists the holidays between the trip dates, both days included. The
+// country is looked up from the last part of the destination, like "Brasil"
+// in "Florianópolis, Brasil", then from the parts before it.
+func (h Holidays) During(destination string, startsAt, endsAt time.Time) []spec.TripHoliday {
+	if len(h) == 0 {
+		return nil
+	}
+
+	parts := strings.Split(destination, ",")
+	for i := len(parts) - 1; i >= 0; i-- {
+		list, ok := h[placeKey(parts[i])]
+		if !ok {
+			continue
+		}
+
+		first := startsAt.UTC().Truncate(24 * time.Hour)
+		last := endsAt.UTC()
+		var during []spec.TripHoliday
+		for _, holiday := range list {
+			if !holiday.date.Before(first) && !holiday.date.After(last) {
+				during = append(during, spec.TripHoliday{Date: openapi_types.Date{Time: holiday.date}, Name: holiday.name})
+			}
+		}
+		return during
+	}
+
+	return nil
+}
+
+// placeKey folds case, accents and spacing, so "São Paulo" and "sao  paulo"
+// find the same place.
+func placeKey(place string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(place)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
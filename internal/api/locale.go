@@ -0,0 +1,88 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// dateLocale renders dates for people reading them in one language. The
+// RFC3339 fields stay the source of truth, these strings are only a courtesy
+// for clients that would rather not format dates themselves.
+type dateLocale struct {
+	date     func(time.Time) string
+	dateTime func(time.Time) string
+}
+
+var ptMonths = [12]string{
+	"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+	"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+}
+
+var esMonths = [12]string{
+	"enero", "febrero", "marzo", "abril", "mayo", "junio",
+	"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+}
+
+// dateLocales is keyed by the primary language subtag, regional variants
+// share the same formats.
+var dateLocales = map[string]dateLocale{
+	"pt": {
+		date: func(t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d", t.Day(), ptMonths[t.Month()-1], t.Year())
+		},
+		dateTime: func(t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d às %s", t.Day(), ptMonths[t.Month()-1], t.Year(), t.Format("15:04"))
+		},
+	},
+	"es": {
+		date: func(t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d", t.Day(), esMonths[t.Month()-1], t.Year())
+		},
+		dateTime: func(t time.Time) string {
+			return fmt.Sprintf("%d de %s de %d a las %s", t.Day(), esMonths[t.Month()-1], t.Year(), t.Format("15:04"))
+		},
+	},
+	"en": {
+		date: func(t time.Time) string {
+			return t.Format("January 2, 2006")
+		},
+		dateTime: func(t time.Time) string {
+			return t.Format("January 2, 2006 at 3:04 PM")
+		},
+	},
+}
+
+// requestLocale picks the locale human readable dates are rendered in. An
+// explicit locale parameter wins and must be supported, otherwise the first
+// supported language of Accept-Language is used. It returns nil when the
+// client asked for none, leaving the formatted fields out.
+func requestLocale(r *http.Request, param *string) (*dateLocale, error) {
+	if param != nil && strings.TrimSpace(*param) != "" {
+		loc, ok := lookupLocale(*param)
+		if !ok {
+			return nil, fmt.Errorf("unsupported locale %q", *param)
+		}
+		return loc, nil
+	}
+
+	for _, lang := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, _, _ := strings.Cut(lang, ";")
+		if loc, ok := lookupLocale(tag); ok {
+			return loc, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func lookupLocale(tag string) (*dateLocale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	primary, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	loc, ok := dateLocales[primary]
+	if !ok {
+		return nil, false
+	}
+	return &loc, true
+}
@@ -0,0 +1,1419 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// memStore is a map backed store for exercising the handlers without a
+// database. It follows the queries closely enough for validation, status
+// codes and not found paths, but skips what only Postgres does well, like
+// unaccented search.
+type memStore struct {
+	mu           sync.Mutex
+	trips        map[uuid.UUID]pgstore.Trip
+	participants map[uuid.UUID]pgstore.Participant
+	activities   map[uuid.UUID]pgstore.Activity
+	links        map[uuid.UUID]pgstore.Link
+	expenses     map[uuid.UUID]pgstore.Expense
+	checklist    map[uuid.UUID]pgstore.ChecklistItem
+	dateOptions  map[uuid.UUID]pgstore.TripDateOption
+	dateVotes    map[uuid.UUID]pgstore.TripDateVote
+	emails       map[uuid.UUID]pgstore.EmailOutbox
+	ownerEmails  map[string]pgstore.OwnerEmailChange
+	suppressions map[string]pgstore.EmailSuppression
+	preferences  map[pgstore.UnsubscribeEmailParams]bool
+}
+
+var _ store = (*memStore)(nil)
+
+func newMemStore() *memStore {
+	return &memStore{
+		trips:        make(map[uuid.UUID]pgstore.Trip),
+		participants: make(map[uuid.UUID]pgstore.Participant),
+		activities:   make(map[uuid.UUID]pgstore.Activity),
+		links:        make(map[uuid.UUID]pgstore.Link),
+		expenses:     make(map[uuid.UUID]pgstore.Expense),
+		checklist:    make(map[uuid.UUID]pgstore.ChecklistItem),
+		dateOptions:  make(map[uuid.UUID]pgstore.TripDateOption),
+		dateVotes:    make(map[uuid.UUID]pgstore.TripDateVote),
+		emails:       make(map[uuid.UUID]pgstore.EmailOutbox),
+		ownerEmails:  make(map[string]pgstore.OwnerEmailChange),
+		suppressions: make(map[string]pgstore.EmailSuppression),
+		preferences:  make(map[pgstore.UnsubscribeEmailParams]bool),
+	}
+}
+
+func memNow() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+}
+
+// CreateTrip ignores the pool, the whole call runs under the store lock.
+func (s *memStore) CreateTrip(ctx context.Context, params spec.CreateTripRequest, inviteTTL time.Duration) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip := pgstore.Trip{
+		ID:            pgstore.NewID(),
+		Destination:   params.Destination,
+		OwnerEmail:    string(params.OwnerEmail),
+		OwnerName:     params.OwnerName,
+		StartsAt:      pgtype.Timestamptz{Valid: !params.StartsAt.IsZero(), Time: params.StartsAt},
+		EndsAt:        pgtype.Timestamptz{Valid: !params.EndsAt.IsZero(), Time: params.EndsAt},
+		IsDraft:       params.Draft,
+		InviteMessage: pgtype.Text{Valid: params.InviteMessage != "", String: params.InviteMessage},
+		Slug:          pgstore.NewSlug(params.Destination),
+		Version:       1,
+		RsvpDeadline:  pgtype.Timestamptz{Valid: !params.RsvpDeadline.IsZero(), Time: params.RsvpDeadline},
+		ReplyTo:       pgtype.Text{Valid: params.ReplyTo != "", String: string(params.ReplyTo)},
+		Timezone:      params.Timezone,
+		IsPolling:     len(params.DateOptions) > 0,
+	}
+	s.trips[trip.ID] = trip
+
+	for _, option := range params.DateOptions {
+		id := pgstore.NewID()
+		s.dateOptions[id] = pgstore.TripDateOption{
+			ID:       id,
+			TripID:   trip.ID,
+			StartsAt: pgtype.Timestamptz{Valid: true, Time: option.StartsAt},
+			EndsAt:   pgtype.Timestamptz{Valid: true, Time: option.EndsAt},
+		}
+	}
+
+	expiresAt := pgstore.InviteExpiry(trip.StartsAt, inviteTTL)
+	for _, email := range params.EmailsToInvite {
+		participant := pgstore.Participant{
+			ID:        pgstore.NewID(),
+			TripID:    trip.ID,
+			Email:     string(email),
+			ExpiresAt: expiresAt,
+		}
+		s.participants[participant.ID] = participant
+	}
+
+	if !params.Draft {
+		s.enqueue(pgstore.EmailOutbox{
+			TripID:    pgtype.UUID{Bytes: trip.ID, Valid: true},
+			Kind:      pgstore.EmailKindConfirmTripOwner,
+			RequestID: pgstore.RequestID(ctx),
+		})
+	}
+
+	return trip.ID, nil
+}
+
+func (s *memStore) GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[participantID]
+	if !ok {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+	return participant, nil
+}
+
+func (s *memStore) ExtendParticipantInvite(ctx context.Context, arg pgstore.ExtendParticipantInviteParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if participant, ok := s.participants[arg.ID]; ok {
+		participant.ExpiresAt = arg.ExpiresAt
+		s.participants[arg.ID] = participant
+	}
+	return nil
+}
+
+func (s *memStore) ChangeParticipantEmail(ctx context.Context, tripID uuid.UUID, arg pgstore.UpdateParticipantEmailParams, invite bool) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[arg.ID]
+	if !ok {
+		return false, nil
+	}
+	for _, other := range s.participants {
+		if other.TripID == participant.TripID && other.ID != participant.ID && strings.EqualFold(other.Email, arg.Email) {
+			return false, nil
+		}
+	}
+
+	participant.Email = arg.Email
+	participant.IsConfirmed = false
+	participant.ExpiresAt = arg.ExpiresAt
+	s.participants[arg.ID] = participant
+
+	if invite {
+		s.enqueue(pgstore.EmailOutbox{
+			TripID:        pgtype.UUID{Bytes: tripID, Valid: true},
+			ParticipantID: pgtype.UUID{Bytes: arg.ID, Valid: true},
+			Kind:          pgstore.EmailKindParticipantInvite,
+			RequestID:     pgstore.RequestID(ctx),
+		})
+	}
+	return true, nil
+}
+
+func (s *memStore) ConfirmParticipant(ctx context.Context, arg pgstore.ConfirmParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[arg.ID]
+	if !ok || participant.IsConfirmed {
+		return 0, nil
+	}
+
+	participant.IsConfirmed = true
+	if arg.PlusOnes.Valid {
+		participant.PlusOnes = arg.PlusOnes.Int32
+	}
+	s.participants[arg.ID] = participant
+	return 1, nil
+}
+
+func (s *memStore) RemoveParticipant(ctx context.Context, arg pgstore.RemoveParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[arg.ID]
+	if !ok || participant.TripID != arg.TripID {
+		return 0, nil
+	}
+	for _, expense := range s.expenses {
+		if expense.PayerID == arg.ID {
+			return 0, &pgconn.PgError{Code: "23503", ConstraintName: "expenses_payer_id_fkey"}
+		}
+	}
+
+	delete(s.participants, arg.ID)
+	for id, email := range s.emails {
+		if email.ParticipantID.Valid && email.ParticipantID.Bytes == arg.ID {
+			delete(s.emails, id)
+		}
+	}
+	for id, item := range s.checklist {
+		if item.AssigneeID.Valid && item.AssigneeID.Bytes == arg.ID {
+			item.AssigneeID = pgtype.UUID{}
+			s.checklist[id] = item
+		}
+	}
+	delete(s.dateVotes, arg.ID)
+	return 1, nil
+}
+
+func (s *memStore) GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[id]
+	if !ok {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return trip, nil
+}
+
+func (s *memStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (s *memStore) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func (s *memStore) GetTripsByIDs(ctx context.Context, ids []uuid.UUID) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, id := range ids {
+		if trip, ok := s.trips[id]; ok {
+			trips = append(trips, trip)
+		}
+	}
+	return trips, nil
+}
+
+// tripListingBefore orders trips like ListTrips, by (starts_at, id) with
+// infinity standing in for a missing start.
+func tripListingBefore(aStart pgtype.Timestamptz, aID uuid.UUID, bStart pgtype.Timestamptz, bID uuid.UUID) bool {
+	rank := func(t pgtype.Timestamptz) pgtype.InfinityModifier {
+		if !t.Valid {
+			return pgtype.Infinity
+		}
+		return t.InfinityModifier
+	}
+	if ra, rb := rank(aStart), rank(bStart); ra != rb {
+		return ra < rb
+	}
+	if rank(aStart) == pgtype.Finite && !aStart.Time.Equal(bStart.Time) {
+		return aStart.Time.Before(bStart.Time)
+	}
+	return bytes.Compare(aID[:], bID[:]) < 0
+}
+
+func (s *memStore) ListTrips(ctx context.Context, arg pgstore.ListTripsParams) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if tripListingBefore(arg.AfterStartsAt, arg.AfterID, trip.StartsAt, trip.ID) {
+			trips = append(trips, trip)
+		}
+	}
+
+	sort.Slice(trips, func(i, j int) bool {
+		return tripListingBefore(trips[i].StartsAt, trips[i].ID, trips[j].StartsAt, trips[j].ID)
+	})
+	return trips[:min(int(arg.Limit), len(trips))], nil
+}
+
+func (s *memStore) GetTripIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, trip := range s.trips {
+		if trip.Slug == slug {
+			return trip.ID, nil
+		}
+	}
+	return uuid.UUID{}, pgx.ErrNoRows
+}
+
+func (s *memStore) ConfirmTrip(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok || trip.IsConfirmed {
+		return false, nil
+	}
+	trip.IsConfirmed = true
+	trip.ConfirmedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	trip.Version++
+	s.trips[tripID] = trip
+
+	for _, participant := range s.participants {
+		if participant.TripID == tripID && !participant.IsConfirmed {
+			s.enqueue(pgstore.EmailOutbox{
+				TripID:        pgtype.UUID{Bytes: tripID, Valid: true},
+				ParticipantID: pgtype.UUID{Bytes: participant.ID, Valid: true},
+				Kind:          pgstore.EmailKindParticipantInvite,
+				RequestID:     pgstore.RequestID(ctx),
+			})
+		}
+	}
+	return true, nil
+}
+
+// RequestOwnerEmailChange uses the e-mail as the token, the handlers never
+// see it.
+func (s *memStore) RequestOwnerEmailChange(ctx context.Context, trip pgstore.Trip, email string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(trip.ID); err != nil {
+		return err
+	}
+
+	for token, change := range s.ownerEmails {
+		if change.TripID == trip.ID && !change.VerifiedAt.Valid {
+			delete(s.ownerEmails, token)
+		}
+	}
+	now := time.Now()
+	s.ownerEmails[email] = pgstore.OwnerEmailChange{
+		Token:     email,
+		TripID:    trip.ID,
+		Email:     email,
+		ExpiresAt: pgtype.Timestamptz{Time: now.Add(ttl), Valid: true},
+		CreatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+	}
+
+	for kind, payload := range map[string]pgstore.OwnerEmailPayload{
+		pgstore.EmailKindOwnerEmailVerify: {To: email, NewEmail: email, Token: email},
+		pgstore.EmailKindOwnerEmailChange: {To: trip.OwnerEmail, NewEmail: email},
+	} {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		s.enqueue(pgstore.EmailOutbox{
+			TripID:    pgtype.UUID{Bytes: trip.ID, Valid: true},
+			Kind:      kind,
+			RequestID: pgstore.RequestID(ctx),
+			Payload:   b,
+		})
+	}
+	return nil
+}
+
+func (s *memStore) VerifyOwnerEmailChange(ctx context.Context, arg pgstore.VerifyOwnerEmailChangeParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	change, ok := s.ownerEmails[arg.Token]
+	now := time.Now()
+	if !ok || change.TripID != arg.TripID || change.VerifiedAt.Valid || !change.ExpiresAt.Time.After(now) {
+		return 0, nil
+	}
+	trip, ok := s.trips[arg.TripID]
+	if !ok {
+		return 0, nil
+	}
+
+	change.VerifiedAt = pgtype.Timestamptz{Time: now, Valid: true}
+	s.ownerEmails[arg.Token] = change
+	trip.OwnerEmail = change.Email
+	trip.Version++
+	s.trips[arg.TripID] = trip
+	return 1, nil
+}
+
+func (s *memStore) PublishTrip(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok || !trip.IsDraft {
+		return false, nil
+	}
+	trip.IsDraft = false
+	trip.Version++
+	s.trips[tripID] = trip
+
+	s.enqueue(pgstore.EmailOutbox{
+		TripID:    pgtype.UUID{Bytes: tripID, Valid: true},
+		Kind:      pgstore.EmailKindConfirmTripOwner,
+		RequestID: pgstore.RequestID(ctx),
+	})
+	return true, nil
+}
+
+func (s *memStore) UpdateTrip(ctx context.Context, arg pgstore.UpdateTripIfVersionParams, notify bool) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[arg.ID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	if trip.Version != arg.Version {
+		return 0, &pgstore.VersionConflictError{Current: trip.Version}
+	}
+
+	changes := pgstore.TripChanges(trip, arg)
+	if notify && trip.IsConfirmed && len(changes) > 0 {
+		payload, err := json.Marshal(changes)
+		if err != nil {
+			return 0, err
+		}
+		for _, participant := range s.participants {
+			if participant.TripID == arg.ID && participant.IsConfirmed {
+				s.enqueue(pgstore.EmailOutbox{
+					TripID:        pgtype.UUID{Bytes: arg.ID, Valid: true},
+					ParticipantID: pgtype.UUID{Bytes: participant.ID, Valid: true},
+					Kind:          pgstore.EmailKindTripUpdated,
+					RequestID:     pgstore.RequestID(ctx),
+					Payload:       payload,
+				})
+			}
+		}
+	}
+
+	trip.Destination = arg.Destination
+	trip.EndsAt = arg.EndsAt
+	trip.StartsAt = arg.StartsAt
+	trip.IsConfirmed = arg.IsConfirmed
+	trip.InviteMessage = arg.InviteMessage
+	trip.RsvpDeadline = arg.RsvpDeadline
+	trip.ReplyTo = arg.ReplyTo
+	trip.IsPolling = false
+	trip.Version++
+	s.trips[arg.ID] = trip
+	return trip.Version, nil
+}
+
+// tripActivities returns the activities of the trip sorted like the
+// duplicate queries, callers that need another order sort again.
+func (s *memStore) tripActivities(tripID uuid.UUID) []pgstore.Activity {
+	var activities []pgstore.Activity
+	for _, activity := range s.activities {
+		if activity.TripID == tripID {
+			activities = append(activities, activity)
+		}
+	}
+
+	sort.Slice(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if !a.OccursAt.Time.Equal(b.OccursAt.Time) {
+			return a.OccursAt.Time.Before(b.OccursAt.Time)
+		}
+		if a.Title != b.Title {
+			return a.Title < b.Title
+		}
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return activities
+}
+
+func (s *memStore) GetTripActivities(ctx context.Context, arg pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := strings.ToLower(arg.Query)
+	var activities []pgstore.Activity
+	for _, activity := range s.tripActivities(arg.TripID) {
+		if query == "" || strings.Contains(strings.ToLower(activity.Title), query) {
+			activities = append(activities, activity)
+		}
+	}
+	sortActivityDays(activities, s.tripLocation(arg.TripID))
+	return activities, nil
+}
+
+func (s *memStore) GetActivitiesForTrips(ctx context.Context, tripIDs []uuid.UUID) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := append([]uuid.UUID(nil), tripIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	var activities []pgstore.Activity
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		tripActivities := s.tripActivities(id)
+		sortActivityDays(tripActivities, s.tripLocation(id))
+		activities = append(activities, tripActivities...)
+	}
+	return activities, nil
+}
+
+// sortActivityDays groups the activities of a trip by day in tz, pinned
+// first and then in time order within a day, the order of the trip activity
+// listings.
+func sortActivityDays(activities []pgstore.Activity, tz *time.Location) {
+	day := func(activity pgstore.Activity) time.Time {
+		y, m, d := activity.OccursAt.Time.In(tz).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, tz)
+	}
+	sort.SliceStable(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if dayA, dayB := day(a), day(b); !dayA.Equal(dayB) {
+			return dayA.Before(dayB)
+		}
+		if a.Pinned != b.Pinned {
+			return a.Pinned
+		}
+		if !a.OccursAt.Time.Equal(b.OccursAt.Time) {
+			return a.OccursAt.Time.Before(b.OccursAt.Time)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+}
+
+// sortActivityListing puts pinned activities first within a time, the order
+// of the activities between two times.
+func sortActivityListing(activities []pgstore.Activity) {
+	sort.SliceStable(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if !a.OccursAt.Time.Equal(b.OccursAt.Time) {
+			return a.OccursAt.Time.Before(b.OccursAt.Time)
+		}
+		return a.Pinned && !b.Pinned
+	})
+}
+
+func (s *memStore) GetTripActivitiesBetween(ctx context.Context, arg pgstore.GetTripActivitiesBetweenParams) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var activities []pgstore.Activity
+	for _, activity := range s.tripActivities(arg.TripID) {
+		if !activity.OccursAt.Time.Before(arg.From.Time) && activity.OccursAt.Time.Before(arg.To.Time) {
+			activities = append(activities, activity)
+		}
+	}
+	sortActivityListing(activities)
+	return activities, nil
+}
+
+func (s *memStore) GetParticipantActivities(ctx context.Context, arg pgstore.GetParticipantActivitiesParams) ([]pgstore.GetParticipantActivitiesRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	confirmed := make(map[uuid.UUID]bool)
+	for _, participant := range s.participants {
+		if participant.Email == arg.Email && participant.IsConfirmed {
+			confirmed[participant.TripID] = true
+		}
+	}
+
+	now := time.Now()
+	var rows []pgstore.GetParticipantActivitiesRow
+	for _, activity := range s.activities {
+		if !confirmed[activity.TripID] || (!arg.IncludePast && activity.OccursAt.Time.Before(now)) {
+			continue
+		}
+		if arg.AfterOccursAt.InfinityModifier == pgtype.Finite {
+			if activity.OccursAt.Time.Before(arg.AfterOccursAt.Time) ||
+				activity.OccursAt.Time.Equal(arg.AfterOccursAt.Time) && activity.ID.String() <= arg.AfterID.String() {
+				continue
+			}
+		}
+		rows = append(rows, pgstore.GetParticipantActivitiesRow{
+			ID:              activity.ID,
+			TripID:          activity.TripID,
+			Title:           activity.Title,
+			OccursAt:        activity.OccursAt,
+			Pinned:          activity.Pinned,
+			RecurrenceGroup: activity.RecurrenceGroup,
+			Latitude:        activity.Latitude,
+			Longitude:       activity.Longitude,
+			DurationMinutes: activity.DurationMinutes,
+			Destination:     s.trips[activity.TripID].Destination,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].OccursAt.Time.Equal(rows[j].OccursAt.Time) {
+			return rows[i].OccursAt.Time.Before(rows[j].OccursAt.Time)
+		}
+		return rows[i].ID.String() < rows[j].ID.String()
+	})
+
+	start := min(int(arg.Offset), len(rows))
+	end := min(start+int(arg.Limit), len(rows))
+	return rows[start:end], nil
+}
+
+type activityKey struct {
+	title    string
+	occursAt time.Time
+}
+
+func (s *memStore) GetDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activities := s.tripActivities(tripID)
+	counts := make(map[activityKey]int)
+	for _, activity := range activities {
+		counts[activityKey{activity.Title, activity.OccursAt.Time.UTC()}]++
+	}
+
+	var duplicates []pgstore.Activity
+	for _, activity := range activities {
+		if counts[activityKey{activity.Title, activity.OccursAt.Time.UTC()}] > 1 {
+			duplicates = append(duplicates, activity)
+		}
+	}
+	return duplicates, nil
+}
+
+func (s *memStore) DeleteDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed []uuid.UUID
+	seen := make(map[activityKey]bool)
+	for _, activity := range s.tripActivities(tripID) {
+		key := activityKey{activity.Title, activity.OccursAt.Time.UTC()}
+		if seen[key] {
+			delete(s.activities, activity.ID)
+			removed = append(removed, activity.ID)
+			continue
+		}
+		seen[key] = true
+	}
+	return removed, nil
+}
+
+func (s *memStore) GetTripActivityStats(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripActivityStatsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stats pgstore.GetTripActivityStatsRow
+	for _, activity := range s.tripActivities(tripID) {
+		if stats.Total == 0 {
+			stats.EarliestAt = activity.OccursAt
+		}
+		stats.LatestAt = activity.OccursAt
+		stats.Total++
+	}
+	return stats, nil
+}
+
+func (s *memStore) GetTripActivityCountsPerDay(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityCountsPerDayRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var days []pgstore.GetTripActivityCountsPerDayRow
+	for _, activity := range s.tripActivities(tripID) {
+		day := activity.OccursAt.Time.UTC().Truncate(24 * time.Hour)
+		if last := len(days) - 1; last >= 0 && days[last].Day.Time.Equal(day) {
+			days[last].Total++
+			continue
+		}
+		days = append(days, pgstore.GetTripActivityCountsPerDayRow{
+			Day:   pgtype.Timestamptz{Time: day, Valid: true},
+			Total: 1,
+		})
+	}
+	return days, nil
+}
+
+func (s *memStore) GetTripParticipants(ctx context.Context, arg pgstore.GetTripParticipantsParams) ([]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := strings.ToLower(arg.Query)
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if participant.TripID != arg.TripID {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(participant.Email), query) &&
+			!strings.Contains(strings.ToLower(participant.Name.String), query) {
+			continue
+		}
+		if participant.Email < arg.AfterEmail ||
+			participant.Email == arg.AfterEmail && participant.ID.String() <= arg.AfterID.String() {
+			continue
+		}
+		participants = append(participants, participant)
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		if participants[i].Email != participants[j].Email {
+			return participants[i].Email < participants[j].Email
+		}
+		return participants[i].ID.String() < participants[j].ID.String()
+	})
+
+	start := min(int(arg.Offset), len(participants))
+	end := min(start+int(arg.Limit), len(participants))
+	return participants[start:end], nil
+}
+
+func (s *memStore) GetParticipantsForTrips(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(tripIDs) > pgstore.MaxParticipantTrips {
+		return nil, fmt.Errorf("memstore: GetParticipantsForTrips takes at most %d trips, got %d", pgstore.MaxParticipantTrips, len(tripIDs))
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(tripIDs))
+	for _, id := range tripIDs {
+		wanted[id] = true
+	}
+
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if wanted[participant.TripID] {
+			participants = append(participants, participant)
+		}
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		a, b := participants[i], participants[j]
+		if a.Email != b.Email {
+			return a.Email < b.Email
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return pgstore.GroupParticipants(participants), nil
+}
+
+func (s *memStore) GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripParticipantStatsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stats pgstore.GetTripParticipantStatsRow
+	for _, participant := range s.participants {
+		if participant.TripID != tripID {
+			continue
+		}
+		stats.Invited++
+		if participant.IsConfirmed {
+			stats.Confirmed++
+			stats.Headcount += 1 + int64(participant.PlusOnes)
+		}
+	}
+	return stats, nil
+}
+
+func (s *memStore) GetOverlappingTrips(ctx context.Context, arg pgstore.GetOverlappingTripsParams) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if trip.OwnerEmail != arg.OwnerEmail || trip.IsDraft {
+			continue
+		}
+		if trip.EndsAt.Time.Before(arg.StartsAt.Time) || trip.StartsAt.Time.After(arg.EndsAt.Time) {
+			continue
+		}
+		trips = append(trips, trip)
+	}
+
+	sort.Slice(trips, func(i, j int) bool { return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time) })
+	return trips, nil
+}
+
+func (s *memStore) CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return uuid.Nil, err
+	}
+	s.links[arg.ID] = pgstore.Link{ID: arg.ID, TripID: arg.TripID, Title: arg.Title, Url: arg.Url}
+	return arg.ID, nil
+}
+
+func (s *memStore) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var links []pgstore.Link
+	for _, link := range s.links {
+		if link.TripID == tripID {
+			links = append(links, link)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].ID.String() < links[j].ID.String() })
+	return links, nil
+}
+
+func (s *memStore) GetTripLink(ctx context.Context, arg pgstore.GetTripLinkParams) (pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, ok := s.links[arg.ID]
+	if !ok || link.TripID != arg.TripID {
+		return pgstore.Link{}, pgx.ErrNoRows
+	}
+	return link, nil
+}
+
+func (s *memStore) CountTripLinks(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int64
+	for _, link := range s.links {
+		if link.TripID == tripID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *memStore) UpdateTripLink(ctx context.Context, arg pgstore.UpdateTripLinkParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, ok := s.links[arg.ID]
+	if !ok || link.TripID != arg.TripID {
+		return 0, nil
+	}
+	link.Title = arg.Title
+	link.Url = arg.Url
+	s.links[arg.ID] = link
+	return 1, nil
+}
+
+func (s *memStore) CreateExpense(ctx context.Context, arg pgstore.CreateExpenseParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return 0, err
+	}
+	for _, expense := range s.expenses {
+		if expense.TripID == arg.TripID && expense.Currency != arg.Currency {
+			return 0, nil
+		}
+	}
+
+	s.expenses[arg.ID] = pgstore.Expense{
+		ID:          arg.ID,
+		TripID:      arg.TripID,
+		Description: arg.Description,
+		Amount:      arg.Amount,
+		Currency:    arg.Currency,
+		PayerID:     arg.PayerID,
+		ActivityID:  arg.ActivityID,
+		CreatedAt:   pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+	}
+	return 1, nil
+}
+
+func (s *memStore) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]pgstore.Expense, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expenses []pgstore.Expense
+	for _, expense := range s.expenses {
+		if expense.TripID == tripID {
+			expenses = append(expenses, expense)
+		}
+	}
+	sort.Slice(expenses, func(i, j int) bool {
+		a, b := expenses[i], expenses[j]
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return expenses, nil
+}
+
+func (s *memStore) GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseTotalsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type key struct {
+		payer    uuid.UUID
+		currency string
+	}
+	totals := make(map[key]int64)
+	for _, expense := range s.expenses {
+		if expense.TripID == tripID {
+			totals[key{expense.PayerID, expense.Currency}] += expense.Amount
+		}
+	}
+
+	rows := make([]pgstore.GetTripExpenseTotalsRow, 0, len(totals))
+	for k, total := range totals {
+		rows = append(rows, pgstore.GetTripExpenseTotalsRow{PayerID: k.payer, Currency: k.currency, Total: total})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].PayerID.String() < rows[j].PayerID.String()
+	})
+	return rows, nil
+}
+
+func (s *memStore) DeleteExpense(ctx context.Context, arg pgstore.DeleteExpenseParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expense, ok := s.expenses[arg.ID]
+	if !ok || expense.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.expenses, arg.ID)
+	return 1, nil
+}
+
+func (s *memStore) CreateChecklistItem(ctx context.Context, arg pgstore.CreateChecklistItemParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return err
+	}
+
+	var position int32
+	for _, item := range s.checklist {
+		if item.TripID == arg.TripID && item.Position > position {
+			position = item.Position
+		}
+	}
+
+	s.checklist[arg.ID] = pgstore.ChecklistItem{
+		ID:              arg.ID,
+		TripID:          arg.TripID,
+		Title:           arg.Title,
+		Position:        position + 1,
+		AssigneeID:      arg.AssigneeID,
+		AssignedToOwner: arg.AssignedToOwner,
+		CreatedAt:       pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+	}
+	return nil
+}
+
+func (s *memStore) GetTripChecklist(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChecklistItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []pgstore.ChecklistItem
+	for _, item := range s.checklist {
+		if item.TripID == tripID {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return items, nil
+}
+
+func (s *memStore) AssignChecklistItem(ctx context.Context, arg pgstore.AssignChecklistItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.checklist[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	item.AssigneeID = arg.AssigneeID
+	item.AssignedToOwner = arg.AssignedToOwner
+	s.checklist[arg.ID] = item
+	return 1, nil
+}
+
+func (s *memStore) SetChecklistItemDone(ctx context.Context, arg pgstore.SetChecklistItemDoneParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.checklist[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	item.Done = arg.Done
+	s.checklist[arg.ID] = item
+	return 1, nil
+}
+
+func (s *memStore) DeleteChecklistItem(ctx context.Context, arg pgstore.DeleteChecklistItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.checklist[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.checklist, arg.ID)
+	return 1, nil
+}
+
+// GetTripDateOptionTallies orders the options like the query, by start then
+// ID.
+func (s *memStore) GetTripDateOptionTallies(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripDateOptionTalliesRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	votes := make(map[uuid.UUID]int64)
+	for _, vote := range s.dateVotes {
+		votes[vote.OptionID]++
+	}
+
+	var tallies []pgstore.GetTripDateOptionTalliesRow
+	for _, option := range s.dateOptions {
+		if option.TripID == tripID {
+			tallies = append(tallies, pgstore.GetTripDateOptionTalliesRow{
+				ID:       option.ID,
+				StartsAt: option.StartsAt,
+				EndsAt:   option.EndsAt,
+				Votes:    votes[option.ID],
+			})
+		}
+	}
+	sort.Slice(tallies, func(i, j int) bool {
+		if !tallies[i].StartsAt.Time.Equal(tallies[j].StartsAt.Time) {
+			return tallies[i].StartsAt.Time.Before(tallies[j].StartsAt.Time)
+		}
+		return tallies[i].ID.String() < tallies[j].ID.String()
+	})
+	return tallies, nil
+}
+
+// VoteTripDateOption keys the votes by participant, each has a single one.
+func (s *memStore) VoteTripDateOption(ctx context.Context, arg pgstore.VoteTripDateOptionParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	option, ok := s.dateOptions[arg.OptionID]
+	if !ok || option.TripID != arg.TripID || !s.trips[arg.TripID].IsPolling {
+		return 0, nil
+	}
+	s.dateVotes[arg.ParticipantID] = pgstore.TripDateVote{
+		TripID:        arg.TripID,
+		ParticipantID: arg.ParticipantID,
+		OptionID:      arg.OptionID,
+		VotedAt:       pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+	}
+	return 1, nil
+}
+
+// SelectTripDateOption ignores the pool, the whole call runs under the store
+// lock.
+func (s *memStore) SelectTripDateOption(ctx context.Context, tripID, optionID uuid.UUID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	option, found := s.dateOptions[optionID]
+	if !ok || !found || option.TripID != tripID || !trip.IsPolling {
+		return false, nil
+	}
+
+	trip.StartsAt = option.StartsAt
+	trip.EndsAt = option.EndsAt
+	trip.IsPolling = false
+	trip.Version++
+	s.trips[tripID] = trip
+
+	if trip.IsConfirmed {
+		payload, err := json.Marshal([]pgstore.TripChange{
+			{Field: "starts_at", New: trip.StartsAt.Time.UTC().Format(time.RFC3339)},
+			{Field: "ends_at", New: trip.EndsAt.Time.UTC().Format(time.RFC3339)},
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, participant := range s.participants {
+			if participant.TripID == tripID && participant.IsConfirmed {
+				s.enqueue(pgstore.EmailOutbox{
+					TripID:        pgtype.UUID{Bytes: tripID, Valid: true},
+					ParticipantID: pgtype.UUID{Bytes: participant.ID, Valid: true},
+					Kind:          pgstore.EmailKindTripUpdated,
+					RequestID:     pgstore.RequestID(ctx),
+					Payload:       payload,
+				})
+			}
+		}
+	}
+	return true, nil
+}
+
+func (s *memStore) GetActivity(ctx context.Context, id uuid.UUID) (pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity, ok := s.activities[id]
+	if !ok {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+	return activity, nil
+}
+
+func (s *memStore) SetActivityPinned(ctx context.Context, arg pgstore.SetActivityPinnedParams) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var updated []uuid.UUID
+	for _, activity := range s.activityTargets(arg.TripID, arg.ID, arg.WholeGroup) {
+		activity.Pinned = arg.Pinned
+		s.activities[activity.ID] = activity
+		updated = append(updated, activity.ID)
+	}
+	return updated, nil
+}
+
+func (s *memStore) DeleteActivities(ctx context.Context, arg pgstore.DeleteActivitiesParams) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed []uuid.UUID
+	for _, activity := range s.activityTargets(arg.TripID, arg.ID, arg.WholeGroup) {
+		delete(s.activities, activity.ID)
+		removed = append(removed, activity.ID)
+	}
+	return removed, nil
+}
+
+// activityTargets is the activity with its recurrence group when wholeGroup
+// is set, what the scoped activity writes match.
+func (s *memStore) activityTargets(tripID, id uuid.UUID, wholeGroup bool) []pgstore.Activity {
+	target, ok := s.activities[id]
+	if !ok || target.TripID != tripID {
+		return nil
+	}
+	if !wholeGroup || !target.RecurrenceGroup.Valid {
+		return []pgstore.Activity{target}
+	}
+
+	var activities []pgstore.Activity
+	for _, activity := range s.activities {
+		if activity.TripID == tripID && activity.RecurrenceGroup == target.RecurrenceGroup {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// checkTrip stands in for the trip_id foreign keys.
+// tripLocation is the time zone of a trip, UTC when it is unknown.
+func (s *memStore) tripLocation(tripID uuid.UUID) *time.Location {
+	loc, err := time.LoadLocation(s.trips[tripID].Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (s *memStore) checkTrip(tripID uuid.UUID) error {
+	if _, ok := s.trips[tripID]; !ok {
+		return fmt.Errorf("memstore: trip %s does not exist", tripID)
+	}
+	return nil
+}
+
+func (s *memStore) InviteParticipantToTrip(ctx context.Context, arg pgstore.InviteParticipantToTripParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	participant := pgstore.Participant{
+		ID:            arg.ID,
+		TripID:        arg.TripID,
+		Email:         arg.Email,
+		InviteMessage: arg.InviteMessage,
+		ExpiresAt:     arg.ExpiresAt,
+		PlusOnes:      arg.PlusOnes,
+	}
+	s.participants[participant.ID] = participant
+	return participant.ID, nil
+}
+
+func (s *memStore) enqueue(email pgstore.EmailOutbox) uuid.UUID {
+	email.ID = pgstore.NewID()
+	email.Status = "pending"
+	email.NextAttemptAt = memNow()
+	email.CreatedAt = email.NextAttemptAt
+	s.emails[email.ID] = email
+	return email.ID
+}
+
+func (s *memStore) EnqueueParticipantEmail(ctx context.Context, arg pgstore.EnqueueParticipantEmailParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return s.enqueue(pgstore.EmailOutbox{
+		TripID:        pgtype.UUID{Bytes: arg.TripID, Valid: true},
+		ParticipantID: arg.ParticipantID,
+		Kind:          arg.Kind,
+		RequestID:     arg.RequestID,
+	}), nil
+}
+
+func (s *memStore) CountRecentParticipantEmails(ctx context.Context, arg pgstore.CountRecentParticipantEmailsParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	since := memNow().Time.Add(-time.Duration(arg.WindowSeconds * float64(time.Second)))
+	var count int64
+	for _, email := range s.emails {
+		if email.ParticipantID == arg.ParticipantID && email.Kind == arg.Kind && email.CreatedAt.Time.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *memStore) CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkTrip(arg.TripID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return s.createActivity(arg), nil
+}
+
+// CreateActivities ignores the pool, the whole call runs under the store
+// lock.
+func (s *memStore) CreateActivities(ctx context.Context, activities []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, arg := range activities {
+		if err := s.checkTrip(arg.TripID); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]uuid.UUID, len(activities))
+	for i, arg := range activities {
+		ids[i] = s.createActivity(arg)
+	}
+	return ids, nil
+}
+
+func (s *memStore) createActivity(arg pgstore.CreateActivityParams) uuid.UUID {
+	activity := pgstore.Activity{
+		ID:                 arg.ID,
+		TripID:             arg.TripID,
+		Title:              arg.Title,
+		OccursAt:           arg.OccursAt,
+		RemindBefore:       arg.RemindBefore,
+		RemindParticipants: arg.RemindParticipants,
+		CreatedAt:          memNow(),
+		RecurrenceGroup:    arg.RecurrenceGroup,
+		Latitude:           arg.Latitude,
+		Longitude:          arg.Longitude,
+		DurationMinutes:    arg.DurationMinutes,
+	}
+	s.activities[activity.ID] = activity
+	return activity.ID
+}
+
+func (s *memStore) GetDeadLetterEmails(ctx context.Context) ([]pgstore.EmailOutbox, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var emails []pgstore.EmailOutbox
+	for _, email := range s.emails {
+		if email.Status == "dead_letter" {
+			emails = append(emails, email)
+		}
+	}
+
+	sort.Slice(emails, func(i, j int) bool { return emails[i].CreatedAt.Time.Before(emails[j].CreatedAt.Time) })
+	return emails, nil
+}
+
+func (s *memStore) ListEmailSuppressions(ctx context.Context) ([]pgstore.EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	suppressions := make([]pgstore.EmailSuppression, 0, len(s.suppressions))
+	for _, suppression := range s.suppressions {
+		suppressions = append(suppressions, suppression)
+	}
+
+	sort.Slice(suppressions, func(i, j int) bool {
+		a, b := suppressions[i], suppressions[j]
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.After(b.CreatedAt.Time)
+		}
+		return a.Email < b.Email
+	})
+	return suppressions, nil
+}
+
+func (s *memStore) GetSuppressedEmails(ctx context.Context, emails []string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var suppressed []string
+	for _, email := range emails {
+		if _, ok := s.suppressions[email]; ok {
+			suppressed = append(suppressed, email)
+		}
+	}
+	return suppressed, nil
+}
+
+func (s *memStore) SuppressEmail(ctx context.Context, arg pgstore.SuppressEmailParams) (pgstore.EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	suppression, ok := s.suppressions[arg.Email]
+	if !ok {
+		suppression = pgstore.EmailSuppression{
+			Email:     arg.Email,
+			CreatedAt: pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+		}
+	}
+	suppression.Reason = arg.Reason
+	suppression.Note = arg.Note
+	s.suppressions[arg.Email] = suppression
+	return suppression, nil
+}
+
+func (s *memStore) DeleteEmailSuppression(ctx context.Context, email string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.suppressions[email]; !ok {
+		return 0, nil
+	}
+	delete(s.suppressions, email)
+	return 1, nil
+}
+
+func (s *memStore) UnsubscribeEmail(ctx context.Context, arg pgstore.UnsubscribeEmailParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.preferences[arg] = true
+	return nil
+}
+
+func (s *memStore) GetGlobalStats(ctx context.Context) (pgstore.GetGlobalStatsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := pgstore.GetGlobalStatsRow{
+		Trips:        int64(len(s.trips)),
+		Activities:   int64(len(s.activities)),
+		Participants: int64(len(s.participants)),
+	}
+	for _, trip := range s.trips {
+		if trip.IsConfirmed {
+			stats.ConfirmedTrips++
+		}
+	}
+	return stats, nil
+}
+
+func (s *memStore) RecordTestEmail(ctx context.Context, arg pgstore.RecordTestEmailParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := pgstore.NewID()
+	s.emails[id] = pgstore.EmailOutbox{
+		ID:            id,
+		Kind:          arg.Kind,
+		Status:        arg.Status,
+		Attempts:      1,
+		LastError:     arg.LastError,
+		NextAttemptAt: memNow(),
+		CreatedAt:     memNow(),
+		RequestID:     arg.RequestID,
+		Payload:       arg.Payload,
+	}
+	return id, nil
+}
+
+func (s *memStore) RequeueEmail(ctx context.Context, id uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	email, ok := s.emails[id]
+	if !ok || email.Status != "dead_letter" {
+		return 0, nil
+	}
+	email.Status = "pending"
+	email.Attempts = 0
+	email.NextAttemptAt = memNow()
+	s.emails[id] = email
+	return 1, nil
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+)
+
+// pagination resolves the optional limit/offset query parameters.
+func pagination(limit, offset *int) (int32, int32, error) {
+	l, o := defaultPageLimit, 0
+	if limit != nil {
+		if *limit < 1 || *limit > maxPageLimit {
+			return 0, 0, errors.New("limit must be between 1 and 100")
+		}
+		l = *limit
+	}
+	if offset != nil {
+		if *offset < 0 {
+			return 0, 0, errors.New("offset must not be negative")
+		}
+		o = *offset
+	}
+	return int32(l), int32(o), nil
+}
+
+// errInvalidCursor is all a client learns about a cursor that doesn't decode,
+// it should start over from the first page.
+var errInvalidCursor = errors.New("cursor is invalid, start again without one")
+
+// cursorOrOffset refuses a page asked for both ways. Offsets only keep
+// working until the clients have moved to cursors.
+func cursorOrOffset(cursor *string, offset *int) error {
+	if cursor != nil && offset != nil {
+		return errors.New("cursor and offset can't be combined, use cursor")
+	}
+	return nil
+}
+
+// encodeCursor makes the sort key and ID of the last row of a page opaque,
+// clients are only meant to send it back.
+func encodeCursor(key string, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "|" + id.String()))
+}
+
+// decodeCursor splits at the last separator, IDs never hold one but keys
+// like e-mails can.
+func decodeCursor(raw string) (string, uuid.UUID, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return "", uuid.UUID{}, errInvalidCursor
+	}
+
+	sep := strings.LastIndexByte(string(data), '|')
+	if sep < 0 {
+		return "", uuid.UUID{}, errInvalidCursor
+	}
+
+	id, err := uuid.Parse(string(data[sep+1:]))
+	if err != nil {
+		return "", uuid.UUID{}, errInvalidCursor
+	}
+	return string(data[:sep]), id, nil
+}
+
+// tripCursor is the key of the last trip on a page, the listing resumes right
+// after it. Undated drafts sort last, their cursor holds infinity.
+type tripCursor struct {
+	startsAt pgtype.Timestamptz
+	id       uuid.UUID
+}
+
+// firstTripCursor sorts before every trip.
+var firstTripCursor = tripCursor{startsAt: pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}}
+
+const cursorInfinity = "infinity"
+
+func newTripCursor(startsAt pgtype.Timestamptz, id uuid.UUID) tripCursor {
+	if !startsAt.Valid {
+		startsAt = pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}
+	}
+	return tripCursor{startsAt: startsAt, id: id}
+}
+
+func (c tripCursor) encode() string {
+	startsAt := cursorInfinity
+	if c.startsAt.InfinityModifier == pgtype.Finite {
+		startsAt = c.startsAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return encodeCursor(startsAt, c.id)
+}
+
+func decodeTripCursor(raw string) (tripCursor, error) {
+	startsAt, id, err := decodeCursor(raw)
+	if err != nil {
+		return tripCursor{}, err
+	}
+
+	c := tripCursor{id: id}
+	if startsAt == cursorInfinity {
+		c.startsAt = pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}
+		return c, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, startsAt)
+	if err != nil {
+		return tripCursor{}, errInvalidCursor
+	}
+	c.startsAt = pgtype.Timestamptz{Time: t, Valid: true}
+	return c, nil
+}
+
+// participantCursor is the key of the last participant on a page of a trip,
+// which lists them by (email, id). The zero cursor sorts before all of them.
+type participantCursor struct {
+	email string
+	id    uuid.UUID
+}
+
+func (c participantCursor) encode() string {
+	return encodeCursor(c.email, c.id)
+}
+
+func decodeParticipantCursor(raw string) (participantCursor, error) {
+	email, id, err := decodeCursor(raw)
+	if err != nil {
+		return participantCursor{}, err
+	}
+	return participantCursor{email: email, id: id}, nil
+}
+
+// activityCursor is the key of the last activity on a page of an itinerary,
+// which lists them by (occurs_at, id).
+type activityCursor struct {
+	occursAt pgtype.Timestamptz
+	id       uuid.UUID
+}
+
+// firstActivityCursor sorts before every activity.
+var firstActivityCursor = activityCursor{occursAt: pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}}
+
+func (c activityCursor) encode() string {
+	return encodeCursor(c.occursAt.Time.UTC().Format(time.RFC3339Nano), c.id)
+}
+
+func decodeActivityCursor(raw string) (activityCursor, error) {
+	occursAt, id, err := decodeCursor(raw)
+	if err != nil {
+		return activityCursor{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, occursAt)
+	if err != nil {
+		return activityCursor{}, errInvalidCursor
+	}
+	return activityCursor{occursAt: pgtype.Timestamptz{Time: t, Valid: true}, id: id}, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the LIKE metacharacters so user input is matched literally.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
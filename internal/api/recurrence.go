@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxOccurrences caps the activities a recurrence expands into, a year of
+// daily ones. The trip length cap keeps real trips well below it.
+const maxOccurrences = 366
+
+var errNoOccurrences = errors.New("recurrence has no occurrence before the trip ends")
+
+// expandRecurrence lists the times a recurring activity happens, from first
+// up to end. Days are stepped in loc so the activity keeps its wall clock
+// time across DST changes. A weekly recurrence without weekdays repeats on
+// the weekday of first.
+func expandRecurrence(first time.Time, recurrence spec.ActivityRecurrence, end time.Time, loc *time.Location) ([]time.Time, error) {
+	first = first.In(loc)
+
+	var weekdays [7]bool
+	switch recurrence.Frequency {
+	case spec.ActivityRecurrenceFrequencyDaily:
+		for i := range weekdays {
+			weekdays[i] = true
+		}
+	case spec.ActivityRecurrenceFrequencyWeekly:
+		if len(recurrence.Weekdays) == 0 {
+			weekdays[first.Weekday()] = true
+		}
+		for _, day := range recurrence.Weekdays {
+			weekdays[day] = true
+		}
+	default:
+		return nil, fmt.Errorf("unknown recurrence frequency %q", recurrence.Frequency.ToValue())
+	}
+
+	var occurrences []time.Time
+	for day := 0; ; day++ {
+		at := first.AddDate(0, 0, day)
+		if at.After(end) {
+			break
+		}
+		if !weekdays[at.Weekday()] {
+			continue
+		}
+		if len(occurrences) == maxOccurrences {
+			return nil, fmt.Errorf("recurrence can't repeat more than %d times", maxOccurrences)
+		}
+		occurrences = append(occurrences, at)
+	}
+
+	if len(occurrences) == 0 {
+		return nil, errNoOccurrences
+	}
+	return occurrences, nil
+}
+
+// wholeGroup reads the scope query parameter of the activity writes, true
+// for the whole recurrence group.
+func wholeGroup(scope string) (bool, error) {
+	switch scope {
+	case "", "occurrence":
+		return false, nil
+	case "group":
+		return true, nil
+	}
+	return false, fmt.Errorf("scope must be occurrence or group, not %q", scope)
+}
+
+// tripLocation is the time zone of the trip, UTC when the stored name no
+// longer loads.
+func (api ApiServer) tripLocation(ctx context.Context, trip pgstore.Trip) *time.Location {
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		api.log(ctx).Warn("unknown trip timezone, using UTC", zap.Error(err), zap.String("tripID", trip.ID.String()), zap.String("timezone", trip.Timezone))
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"encoding/json"
+	"journey/internal/api/spec"
+	"net/http"
+)
+
+// Error codes let clients branch on a failure without parsing the message.
+const (
+	codeInvalidID          = "invalid_id"
+	codeInvalidJSON        = "invalid_json"
+	codeInvalidInput       = "invalid_input"
+	codeNotFound           = "not_found"
+	codeUnauthorized       = "unauthorized"
+	codeForbidden          = "forbidden"
+	codeAlreadyConfirmed   = "already_confirmed"
+	codeAlreadyPublished   = "already_published"
+	codeAlreadyInvited     = "already_invited"
+	codeTripDraft          = "trip_draft"
+	codeInviteExpired      = "invite_expired"
+	codeTokenExpired       = "token_expired"
+	codeRSVPClosed         = "rsvp_closed"
+	codeTripLocked         = "trip_locked"
+	codeCurrencyMismatch   = "currency_mismatch"
+	codeExpensePayer       = "expense_payer"
+	codePollClosed         = "poll_closed"
+	codeEmailDomainBlocked = "email_domain_blocked"
+	codeRateLimited        = "rate_limited"
+	codeTimeout            = "timeout"
+	codeInternal           = "internal"
+)
+
+// respondError answers a handler failure. Every error shares the Error
+// schema, so handlers don't go through the per-route constructors for them.
+func respondError(status int, code, message string) *spec.Response {
+	return spec.ErrorResponse(status, spec.Error{Code: code, Message: message})
+}
+
+// respondJSON writes body straight to w, for the middlewares that answer
+// before a handler runs.
+func respondJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	respondJSON(w, status, spec.Error{Code: code, Message: message})
+}
+
+// ParamError answers the requests the generated router rejects before they
+// reach a handler, like a malformed query parameter, in the same shape as
+// every other error.
+func ParamError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, http.StatusBadRequest, codeInvalidInput, err.Error())
+}
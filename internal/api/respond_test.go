@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+// failingStore fails the activity reads, the path the activities handler
+// used to answer with the constructor of another route.
+type failingStore struct {
+	*memStore
+	err error
+}
+
+func (s failingStore) GetTripActivities(context.Context, pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error) {
+	return nil, s.err
+}
+
+func TestErrorResponses(t *testing.T) {
+	store := newMemStore()
+	trip := addTrip(store, pgstore.Trip{Destination: "Lisboa", Timezone: "UTC"})
+	tripPath := "/trips/" + trip.ID.String()
+	unknownPath := "/trips/" + uuid.NewString()
+
+	h := newTestHandler(store)
+	broken := newTestHandler(failingStore{store, errors.New("connection reset")})
+	slow := newTestHandler(failingStore{store, context.DeadlineExceeded})
+	admin := AdminOnly("secret")(h)
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		method  string
+		path    string
+		body    string
+		status  int
+		code    string
+	}{
+		{"invalid json", h, http.MethodPost, "/trips", `{`, http.StatusBadRequest, codeInvalidJSON},
+		{"invalid input", h, http.MethodPost, "/trips", `{}`, http.StatusBadRequest, codeInvalidInput},
+		{"invalid id", h, http.MethodPatch, "/participants/42/confirm", "", http.StatusBadRequest, codeInvalidID},
+		{"invalid query parameter", h, http.MethodGet, tripPath + "/participants?limit=many", "", http.StatusBadRequest, codeInvalidInput},
+		{"unknown trip", h, http.MethodGet, unknownPath, "", http.StatusNotFound, codeNotFound},
+		{"unknown trip slug", h, http.MethodGet, "/trips/nowhere-123", "", http.StatusNotFound, codeNotFound},
+		{"update unknown trip", h, http.MethodPut, unknownPath, `{"destination":"Porto","starts_at":"2030-07-10","ends_at":"2030-07-12","version":1}`, http.StatusNotFound, codeNotFound},
+		{"unknown participant", h, http.MethodPatch, "/participants/" + uuid.NewString() + "/confirm", "", http.StatusNotFound, codeNotFound},
+		{"unknown trip activities", h, http.MethodGet, unknownPath + "/activities", "", http.StatusNotFound, codeNotFound},
+		{"unknown trip participants", h, http.MethodGet, unknownPath + "/participants", "", http.StatusNotFound, codeNotFound},
+		{"unknown trip checklist", h, http.MethodGet, unknownPath + "/checklist", "", http.StatusNotFound, codeNotFound},
+		{"unknown trip expenses", h, http.MethodGet, unknownPath + "/expenses", "", http.StatusNotFound, codeNotFound},
+		{"unknown activity", h, http.MethodDelete, tripPath + "/activities/" + uuid.NewString(), "", http.StatusNotFound, codeNotFound},
+		{"activities store failure", broken, http.MethodGet, tripPath + "/activities", "", http.StatusInternalServerError, codeInternal},
+		{"activities timeout", slow, http.MethodGet, tripPath + "/activities", "", http.StatusGatewayTimeout, codeTimeout},
+		{"admin without token", admin, http.MethodGet, "/admin/stats", "", http.StatusUnauthorized, codeUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Errorf("status %d, want %d", rec.Code, tt.status)
+			}
+			if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+				t.Errorf("content type %q, want application/json", ct)
+			}
+
+			// Every error has the Error schema and nothing else.
+			var body map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %q: %v", rec.Body, err)
+			}
+			message, _ := body["message"].(string)
+			if len(body) != 2 || body["code"] != tt.code || message == "" {
+				t.Errorf("body %v, want code %q and a message", body, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"time"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultScheduleGap is the idle time between two activities of a day the
+// schedule check reports when the request doesn't pick one.
+const defaultScheduleGap = 180
+
+var errActivityPastTripEnd = errors.New("activity must end by the end of the trip, shorten duration_minutes")
+
+// durationMinutes is the column value of an optional activity duration.
+func durationMinutes(v *int) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
+
+// durationMinutesOrNil is the response value of a nullable activity
+// duration.
+func durationMinutesOrNil(d pgtype.Int4) *int {
+	if !d.Valid {
+		return nil
+	}
+	v := int(d.Int32)
+	return &v
+}
+
+// activityEnd is when the activity is over, its start for the ones without
+// a duration.
+func activityEnd(activity pgstore.Activity) time.Time {
+	end := utc(activity.OccursAt)
+	if activity.DurationMinutes.Valid {
+		end = end.Add(time.Duration(activity.DurationMinutes.Int32) * time.Minute)
+	}
+	return end
+}
+
+// checkActivityEnd refuses a duration running past the end of the trip.
+// Trips without an end date take any duration.
+func checkActivityEnd(trip pgstore.Trip, occursAt time.Time, duration *int) error {
+	if duration == nil || !trip.EndsAt.Valid {
+		return nil
+	}
+	if occursAt.Add(time.Duration(*duration) * time.Minute).After(trip.EndsAt.Time) {
+		return errActivityPastTripEnd
+	}
+	return nil
+}
+
+// checkSchedule lists the overlaps and the idle gaps of at least gap between
+// the activities, which must come sorted by occurs_at. Each activity is
+// compared with the one that ends last among those before it, so a long
+// activity overlapping several shorter ones is reported once for each. Gaps
+// are only looked for within a day of loc, nights aren't idle time.
+func checkSchedule(activities []pgstore.Activity, loc *time.Location, gap time.Duration) []spec.ScheduleIssue {
+	issues := []spec.ScheduleIssue{}
+
+	var latest pgstore.Activity
+	var latestEnd time.Time
+	for i, activity := range activities {
+		start, end := utc(activity.OccursAt), activityEnd(activity)
+
+		switch {
+		case i == 0:
+		case start.Before(latestEnd):
+			issues = append(issues, scheduleIssue(spec.ScheduleIssueTypeOverlap, latest, activity, start, earliest(end, latestEnd), loc))
+		case start.Sub(latestEnd) >= gap && sameDay(latestEnd, start, loc):
+			issues = append(issues, scheduleIssue(spec.ScheduleIssueTypeGap, latest, activity, latestEnd, start, loc))
+		}
+
+		if i == 0 || end.After(latestEnd) {
+			latest, latestEnd = activity, end
+		}
+	}
+
+	return issues
+}
+
+// scheduleIssue reports the overlap or the gap from start to end between
+// two activities, dated on the day of the second.
+func scheduleIssue(kind spec.ScheduleIssueType, first, second pgstore.Activity, start, end time.Time, loc *time.Location) spec.ScheduleIssue {
+	y, m, d := second.OccursAt.Time.In(loc).Date()
+	return spec.ScheduleIssue{
+		Type:        kind,
+		Date:        openapi_types.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)},
+		ActivityIds: []string{first.ID.String(), second.ID.String()},
+		StartsAt:    start,
+		EndsAt:      end,
+		Minutes:     int(end.Sub(start) / time.Minute),
+	}
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func sameDay(a, b time.Time, loc *time.Location) bool {
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+	return ay == by && am == bm && ad == bd
+}
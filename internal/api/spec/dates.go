@@ -0,0 +1,99 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TripDateError is returned when a trip date is neither an RFC3339 timestamp
+// nor a plain date, its message lists the accepted formats.
+type TripDateError struct {
+	Field string
+	Value string
+}
+
+func (e *TripDateError) Error() string {
+	return fmt.Sprintf(
+		"invalid %s %q: expected an RFC3339 timestamp like 2025-07-10T09:00:00Z or a date like 2025-07-10",
+		e.Field, e.Value,
+	)
+}
+
+// tripDate decodes trip dates, frontends with a date picker send plain dates
+// and those are read as midnight UTC since trips carry no time zone.
+type tripDate struct {
+	field string
+	time  *time.Time
+}
+
+func (d tripDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return &TripDateError{Field: d.field, Value: string(data)}
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			*d.time = t
+			return nil
+		}
+	}
+
+	return &TripDateError{Field: d.field, Value: value}
+}
+
+// UnmarshalJSON accepts date-only values for starts_at, ends_at and
+// rsvp_deadline.
+func (r *CreateTripRequest) UnmarshalJSON(data []byte) error {
+	type plain CreateTripRequest
+	aux := struct {
+		*plain
+		StartsAt     tripDate `json:"starts_at"`
+		EndsAt       tripDate `json:"ends_at"`
+		RsvpDeadline tripDate `json:"rsvp_deadline"`
+	}{
+		plain:        (*plain)(r),
+		StartsAt:     tripDate{"starts_at", &r.StartsAt},
+		EndsAt:       tripDate{"ends_at", &r.EndsAt},
+		RsvpDeadline: tripDate{"rsvp_deadline", &r.RsvpDeadline},
+	}
+	return json.Unmarshal(data, &aux)
+}
+
+// UnmarshalJSON accepts date-only values for starts_at, ends_at and
+// rsvp_deadline.
+func (r *UpdateTripRequest) UnmarshalJSON(data []byte) error {
+	type plain UpdateTripRequest
+	aux := struct {
+		*plain
+		StartsAt     tripDate `json:"starts_at"`
+		EndsAt       tripDate `json:"ends_at"`
+		RsvpDeadline tripDate `json:"rsvp_deadline"`
+	}{
+		plain:        (*plain)(r),
+		StartsAt:     tripDate{"starts_at", &r.StartsAt},
+		EndsAt:       tripDate{"ends_at", &r.EndsAt},
+		RsvpDeadline: tripDate{"rsvp_deadline", &r.RsvpDeadline},
+	}
+	return json.Unmarshal(data, &aux)
+}
+
+// UnmarshalJSON accepts date-only values for starts_at and ends_at.
+func (o *TripDateOptionInput) UnmarshalJSON(data []byte) error {
+	type plain TripDateOptionInput
+	aux := struct {
+		*plain
+		StartsAt tripDate `json:"starts_at"`
+		EndsAt   tripDate `json:"ends_at"`
+	}{
+		plain:    (*plain)(o),
+		StartsAt: tripDate{"starts_at", &o.StartsAt},
+		EndsAt:   tripDate{"ends_at", &o.EndsAt},
+	}
+	return json.Unmarshal(data, &aux)
+}
@@ -0,0 +1,11 @@
+package spec
+
+// ErrorResponse answers any route with the shared Error schema. Handlers use
+// it instead of the per-route constructors, which only differ in the status.
+func ErrorResponse(status int, body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        status,
+		contentType: "application/json",
+	}
+}
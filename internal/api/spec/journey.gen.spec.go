@@ -23,15 +23,192 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for ActivityRecurrenceFrequency.
+var (
+	UnknownActivityRecurrenceFrequency = ActivityRecurrenceFrequency{}
+
+	ActivityRecurrenceFrequencyDaily = ActivityRecurrenceFrequency{"daily"}
+
+	ActivityRecurrenceFrequencyWeekly = ActivityRecurrenceFrequency{"weekly"}
+)
+
+// Defines values for BatchRequestItemMethod.
+var (
+	UnknownBatchRequestItemMethod = BatchRequestItemMethod{}
+
+	BatchRequestItemMethodPost = BatchRequestItemMethod{"POST"}
+
+	BatchRequestItemMethodPut = BatchRequestItemMethod{"PUT"}
+)
+
+// Defines values for EmailSuppressionReason.
+var (
+	UnknownEmailSuppressionReason = EmailSuppressionReason{}
+
+	EmailSuppressionReasonBounce = EmailSuppressionReason{"bounce"}
+
+	EmailSuppressionReasonComplaint = EmailSuppressionReason{"complaint"}
+
+	EmailSuppressionReasonManual = EmailSuppressionReason{"manual"}
+)
+
+// Defines values for ScheduleIssueType.
+var (
+	UnknownScheduleIssueType = ScheduleIssueType{}
+
+	ScheduleIssueTypeGap = ScheduleIssueType{"gap"}
+
+	ScheduleIssueTypeOverlap = ScheduleIssueType{"overlap"}
+)
+
+// Repeats the activity at the same local time, in the trip time zone, until the trip ends. Needs a trip with both dates.
+type ActivityRecurrence struct {
+	Frequency ActivityRecurrenceFrequency `json:"frequency"`
+
+	// The days a weekly activity repeats on, 0 is Sunday.
+	Weekdays []int `json:"weekdays,omitempty" validate:"omitempty,max=7,dive,min=0,max=6"`
+}
+
+// Neither participant_id nor owner unassigns the item.
+type AssignChecklistItemRequest struct {
+	Owner         bool   `json:"owner,omitempty"`
+	ParticipantID string `json:"participant_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	Requests []BatchRequestItem `json:"requests" validate:"required,min=1,max=50,dive"`
+}
+
+// BatchRequestItem defines model for BatchRequestItem.
+type BatchRequestItem struct {
+	// The JSON body of the sub-request.
+	Body   json.RawMessage        `json:"body,omitempty"`
+	Method BatchRequestItemMethod `json:"method"`
+	Path   string                 `json:"path" validate:"required"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	Responses []BatchResponseItem `json:"responses"`
+}
+
+// BatchResponseItem defines model for BatchResponseItem.
+type BatchResponseItem struct {
+	// The JSON body the sub-request answered, if any.
+	Body   json.RawMessage `json:"body,omitempty"`
+	Status int             `json:"status"`
+}
+
+// ChangeOwnerEmailRequest defines model for ChangeOwnerEmailRequest.
+type ChangeOwnerEmailRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// ChangeParticipantEmailRequest defines model for ChangeParticipantEmailRequest.
+type ChangeParticipantEmailRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// The items of one assignee, the unassigned ones have neither participant_id nor owner.
+type ChecklistGroup struct {
+	Items         []ChecklistItem `json:"items"`
+	Owner         bool            `json:"owner,omitempty"`
+	ParticipantID string          `json:"participant_id,omitempty"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Done     bool   `json:"done"`
+	ID       string `json:"id"`
+	Position int    `json:"position"`
+	Title    string `json:"title"`
+}
+
+// ConfirmParticipantRequest defines model for ConfirmParticipantRequest.
+type ConfirmParticipantRequest struct {
+	// Companions the participant brings along, omit it to keep the number given on the invite.
+	PlusOnes *int `json:"plus_ones,omitempty" validate:"omitempty,min=0"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	// How long the activity lasts, a point in time when omitted. It must end by the end of the trip.
+	DurationMinutes *int `json:"duration_minutes,omitempty" validate:"omitempty,min=1,max=1440"`
+
+	// Where the activity happens, set along with longitude.
+	Latitude *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+
+	// Where the activity happens, set along with latitude.
+	Longitude *float64  `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+	OccursAt  time.Time `json:"occurs_at" validate:"required"`
+
+	// Repeats the activity at the same local time, in the trip time zone, until the trip ends. Needs a trip with both dates.
+	Recurrence *ActivityRecurrence `json:"recurrence,omitempty"`
+
+	// Minutes before occurs_at to e-mail a reminder, no reminder when omitted.
+	RemindBefore *int `json:"remind_before,omitempty" validate:"omitempty,min=1,max=525600"`
+
+	// Also remind the confirmed participants, not only the owner.
+	RemindParticipants bool   `json:"remind_participants,omitempty"`
+	Title              string `json:"title" validate:"required"`
 }
 
 // CreateActivityResponse defines model for CreateActivityResponse.
 type CreateActivityResponse struct {
+	// The activity, or the first occurrence of a recurring one.
 	ActivityID string `json:"activityId"`
+
+	// Every occurrence of a recurring activity, in time order.
+	OccurrenceIds []string `json:"occurrence_ids,omitempty"`
+
+	// Shared by the occurrences of a recurring activity.
+	RecurrenceGroup string `json:"recurrence_group,omitempty"`
+}
+
+// CreateChecklistItemRequest defines model for CreateChecklistItemRequest.
+type CreateChecklistItemRequest struct {
+	// The trip owner brings the item, can't be combined with participant_id.
+	Owner bool `json:"owner,omitempty"`
+
+	// The participant who brings the item.
+	ParticipantID string `json:"participant_id,omitempty" validate:"omitempty,uuid"`
+	Title         string `json:"title" validate:"required,max=255"`
+}
+
+// CreateChecklistItemResponse defines model for CreateChecklistItemResponse.
+type CreateChecklistItemResponse struct {
+	ItemID string `json:"itemId"`
+}
+
+// CreateEmailSuppressionRequest defines model for CreateEmailSuppressionRequest.
+type CreateEmailSuppressionRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+	Note  string              `json:"note,omitempty" validate:"omitempty,max=500"`
+
+	// Bounces are added by the mailer when the server refuses the recipient for good.
+	Reason EmailSuppressionReason `json:"reason"`
+}
+
+// CreateExpenseRequest defines model for CreateExpenseRequest.
+type CreateExpenseRequest struct {
+	// The activity the cost belongs to.
+	ActivityID string `json:"activity_id,omitempty" validate:"omitempty,uuid"`
+
+	// In the minor unit of the currency, cents for BRL, up to a billion units so the trip totals can't overflow.
+	Amount int64 `json:"amount" validate:"required,min=1,max=100000000000"`
+
+	// ISO 4217 code, the same for every expense of the trip.
+	Currency    string `json:"currency" validate:"required,iso4217"`
+	Description string `json:"description" validate:"required,max=255"`
+
+	// The participant who paid.
+	PayerID string `json:"payer_id" validate:"required,uuid"`
+}
+
+// CreateExpenseResponse defines model for CreateExpenseResponse.
+type CreateExpenseResponse struct {
+	ExpenseID string `json:"expenseId"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
@@ -47,24 +224,161 @@ type CreateLinkResponse struct {
 
 // CreateTripRequest defines model for CreateTripRequest.
 type CreateTripRequest struct {
-	Destination    string                `json:"destination" validate:"required,min=4"`
+	// Candidate date ranges for participants to vote on, in place of starts_at and ends_at. The trip takes its dates once the owner selects one.
+	DateOptions []TripDateOptionInput `json:"date_options,omitempty" validate:"omitempty,min=2,max=10,dive"`
+	Destination string                `json:"destination" validate:"required,min=4"`
+
+	// Draft trips skip the owner confirmation e-mail until they are published.
+	Draft          bool                  `json:"draft,omitempty"`
 	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	EndsAt time.Time `json:"ends_at,omitempty" validate:"required_without_all=Draft DateOptions"`
+
+	// A personal note shown in the invite e-mails.
+	InviteMessage string              `json:"invite_message,omitempty" validate:"omitempty,max=500"`
+	OwnerEmail    openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName     string              `json:"owner_name" validate:"required"`
+
+	// Where replies to the trip e-mails go, the owner e-mail when left out. Messages are still sent from the Journey address.
+	ReplyTo openapi_types.Email `json:"reply_to,omitempty" validate:"omitempty,email"`
+
+	// Participants can confirm until then, it must be in the future and before starts_at. An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	RsvpDeadline time.Time `json:"rsvp_deadline,omitempty"`
+
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	StartsAt time.Time `json:"starts_at,omitempty" validate:"required_without_all=Draft DateOptions"`
+
+	// The IANA time zone of the trip, like America/Sao_Paulo. The server default when left out.
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,max=64"`
 }
 
 // CreateTripResponse defines model for CreateTripResponse.
 type CreateTripResponse struct {
-	TripID string `json:"tripId"`
+	// Mail delivery is failing right now, the confirmation e-mail is queued and goes out once it recovers.
+	EmailDelayed bool `json:"email_delayed,omitempty"`
+
+	// Public holidays of the destination country during the trip, for information only.
+	Holidays []TripHoliday `json:"holidays,omitempty"`
+
+	// Addresses of the trip on the suppression list, the owner included, no e-mail will be sent to them.
+	SuppressedEmails []string `json:"suppressed_emails,omitempty"`
+	TripID           string   `json:"tripId"`
+
+	// Non blocking issues found with the trip, such as dates overlapping another trip of the owner.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DeadLetterEmail defines model for DeadLetterEmail.
+type DeadLetterEmail struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	LastError string    `json:"last_error"`
+
+	// The request that queued the e-mail, when there was one.
+	RequestID string `json:"request_id,omitempty"`
+
+	// Absent for the e-mails of no trip, like test e-mails.
+	TripID string `json:"trip_id,omitempty"`
+}
+
+// DedupeActivitiesResponse defines model for DedupeActivitiesResponse.
+type DedupeActivitiesResponse struct {
+	Removed int64 `json:"removed"`
+}
+
+// EmailSuppression defines model for EmailSuppression.
+type EmailSuppression struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// The address lowercased, the key it is matched by.
+	Email string `json:"email"`
+	Note  string `json:"note,omitempty"`
+
+	// Bounces are added by the mailer when the server refuses the recipient for good.
+	Reason EmailSuppressionReason `json:"reason"`
 }
 
 // Bad request
 type Error struct {
+	// A stable identifier of the failure, like not_found or invalid_input, for clients to branch on.
+	Code string `json:"code"`
+
+	// The underlying error, only given with ?debug=true outside of production.
+	Detail  string `json:"detail,omitempty"`
 	Message string `json:"message"`
 }
 
+// ExpenseSummaryBalance defines model for ExpenseSummaryBalance.
+type ExpenseSummaryBalance struct {
+	// paid less share, positive when the others owe the participant.
+	Balance       int64  `json:"balance"`
+	Paid          int64  `json:"paid"`
+	ParticipantID string `json:"participant_id"`
+	Share         int64  `json:"share"`
+}
+
+// ExpenseSummaryPayer defines model for ExpenseSummaryPayer.
+type ExpenseSummaryPayer struct {
+	ParticipantID string `json:"participant_id"`
+	Total         int64  `json:"total"`
+}
+
+// ExtendInviteResponse defines model for ExtendInviteResponse.
+type ExtendInviteResponse struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// GetActivitiesBatchRequest defines model for GetActivitiesBatchRequest.
+type GetActivitiesBatchRequest struct {
+	TripIds []string `json:"trip_ids" validate:"required,min=1,max=50"`
+}
+
+// GetActivitiesBatchResponse defines model for GetActivitiesBatchResponse.
+type GetActivitiesBatchResponse struct {
+	Trips []GetActivitiesBatchResponseTrip `json:"trips"`
+}
+
+// GetActivitiesBatchResponseTrip defines model for GetActivitiesBatchResponseTrip.
+type GetActivitiesBatchResponseTrip struct {
+	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+	TripID     string                                `json:"trip_id"`
+}
+
+// GetDeadLetterEmailsResponse defines model for GetDeadLetterEmailsResponse.
+type GetDeadLetterEmailsResponse struct {
+	Emails []DeadLetterEmail `json:"emails"`
+}
+
+// GetDuplicateActivitiesResponse defines model for GetDuplicateActivitiesResponse.
+type GetDuplicateActivitiesResponse struct {
+	Groups []GetDuplicateActivitiesResponseGroup `json:"groups"`
+}
+
+// GetDuplicateActivitiesResponseGroup defines model for GetDuplicateActivitiesResponseGroup.
+type GetDuplicateActivitiesResponseGroup struct {
+	// Oldest first, the first one is kept by a dedupe.
+	ActivityIds []string  `json:"activity_ids"`
+	OccursAt    time.Time `json:"occurs_at"`
+	Title       string    `json:"title"`
+}
+
+// GetGlobalStatsResponse defines model for GetGlobalStatsResponse.
+type GetGlobalStatsResponse struct {
+	Activities       int64 `json:"activities"`
+	ConfirmedTrips   int64 `json:"confirmed_trips"`
+	Participants     int64 `json:"participants"`
+	Trips            int64 `json:"trips"`
+	UnconfirmedTrips int64 `json:"unconfirmed_trips"`
+}
+
+// GetLinkResponse defines model for GetLinkResponse.
+type GetLinkResponse struct {
+	Link GetLinksResponseArray `json:"link"`
+}
+
 // GetLinksResponse defines model for GetLinksResponse.
 type GetLinksResponse struct {
 	Links []GetLinksResponseArray `json:"links"`
@@ -77,6 +391,42 @@ type GetLinksResponseArray struct {
 	URL   string `json:"url"`
 }
 
+// GetParticipantActivitiesResponse defines model for GetParticipantActivitiesResponse.
+type GetParticipantActivitiesResponse struct {
+	Activities []ParticipantActivity `json:"activities"`
+
+	// Left out on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// GetParticipantStatusResponse defines model for GetParticipantStatusResponse.
+type GetParticipantStatusResponse struct {
+	// The participant Gravatar, absent when avatars are turned off.
+	AvatarURL   string                              `json:"avatar_url,omitempty"`
+	ID          string                              `json:"id"`
+	IsConfirmed bool                                `json:"is_confirmed"`
+	Trip        GetParticipantStatusResponseTripObj `json:"trip"`
+}
+
+// GetParticipantStatusResponseTripObj defines model for GetParticipantStatusResponseTripObj.
+type GetParticipantStatusResponseTripObj struct {
+	Destination string    `json:"destination"`
+	EndsAt      time.Time `json:"ends_at"`
+	ID          string    `json:"id"`
+	OwnerName   string    `json:"owner_name"`
+	StartsAt    time.Time `json:"starts_at"`
+}
+
+// GetTodayActivitiesResponse defines model for GetTodayActivitiesResponse.
+type GetTodayActivitiesResponse struct {
+	// Sorted by time, empty on a free day.
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+
+	// Today in the trip time zone.
+	Date     openapi_types.Date `json:"date"`
+	Timezone string             `json:"timezone"`
+}
+
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
 	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
@@ -84,15 +434,62 @@ type GetTripActivitiesResponse struct {
 
 // GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
 type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+	// Absent when the activity is a point in time.
+	DurationMinutes *int   `json:"duration_minutes,omitempty"`
+	ID              string `json:"id"`
+
+	// Absent along with longitude when the activity has no location.
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Absent along with latitude when the activity has no location.
+	Longitude *float64  `json:"longitude,omitempty"`
+	OccursAt  time.Time `json:"occurs_at"`
+
+	// occurs_at in the requested locale and the trip time zone, only present when one was requested.
+	OccursAtFormatted string `json:"occurs_at_formatted,omitempty"`
+	Pinned            bool   `json:"pinned"`
+
+	// Shared by the occurrences of a recurring activity.
+	RecurrenceGroup string `json:"recurrence_group,omitempty"`
+	Title           string `json:"title"`
 }
 
 // GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
 type GetTripActivitiesResponseOuterArray struct {
+	// Pinned activities first, then in time order.
 	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
-	Date       time.Time                             `json:"date"`
+
+	// The start of the day the activities occur on in the trip time zone, as a UTC time.
+	Date time.Time `json:"date"`
+
+	// date in the requested locale, only present when one was requested.
+	DateFormatted string `json:"date_formatted,omitempty"`
+}
+
+// GetTripActivityStatsResponse defines model for GetTripActivityStatsResponse.
+type GetTripActivityStatsResponse struct {
+	EarliestAt *time.Time                        `json:"earliest_at"`
+	LatestAt   *time.Time                        `json:"latest_at"`
+	PerDay     []GetTripActivityStatsResponseDay `json:"per_day"`
+	Total      int64                             `json:"total"`
+}
+
+// GetTripActivityStatsResponseDay defines model for GetTripActivityStatsResponseDay.
+type GetTripActivityStatsResponseDay struct {
+	Date  time.Time `json:"date"`
+	Total int64     `json:"total"`
+}
+
+// GetTripChecklistResponse defines model for GetTripChecklistResponse.
+type GetTripChecklistResponse struct {
+	// The owner items first, then each participant in the order of their first item, then the unassigned items.
+	Groups []ChecklistGroup `json:"groups"`
+}
+
+// GetTripDateOptionsResponse defines model for GetTripDateOptionsResponse.
+type GetTripDateOptionsResponse struct {
+	IsPolling bool             `json:"is_polling"`
+	Options   []TripDateOption `json:"options"`
 }
 
 // GetTripDetailsResponse defines model for GetTripDetailsResponse.
@@ -104,409 +501,4004 @@ type GetTripDetailsResponse struct {
 type GetTripDetailsResponseTripObj struct {
 	Destination string    `json:"destination"`
 	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+
+	// ends_at in the requested locale, only present when one was requested.
+	EndsAtFormatted string `json:"ends_at_formatted,omitempty"`
+	ID              string `json:"id"`
+	IsConfirmed     bool   `json:"is_confirmed"`
+	IsDraft         bool   `json:"is_draft"`
+
+	// Participants are still voting on the dates, starts_at and ends_at are unset until the owner selects an option.
+	IsPolling bool `json:"is_polling"`
+
+	// When confirmations close, absent when the trip has no deadline.
+	RsvpDeadline *time.Time `json:"rsvp_deadline,omitempty"`
+
+	// A short identifier for share links, accepted wherever the trip ID is.
+	Slug     string    `json:"slug"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// starts_at in the requested locale, only present when one was requested.
+	StartsAtFormatted string `json:"starts_at_formatted,omitempty"`
+
+	// The IANA time zone of the trip.
+	Timezone string `json:"timezone"`
+
+	// Changes on every update, send it back when updating the trip.
+	Version int32 `json:"version"`
+}
+
+// GetTripExpenseSummaryResponse defines model for GetTripExpenseSummaryResponse.
+type GetTripExpenseSummaryResponse struct {
+	// One per confirmed participant. The shares add up to the total, the minor units that don't divide evenly go one each to the first participants.
+	Balances []ExpenseSummaryBalance `json:"balances"`
+
+	// Missing while the trip has no expenses.
+	Currency string                `json:"currency,omitempty"`
+	Payers   []ExpenseSummaryPayer `json:"payers"`
+	Total    int64                 `json:"total"`
+}
+
+// GetTripExpensesResponse defines model for GetTripExpensesResponse.
+type GetTripExpensesResponse struct {
+	Expenses []TripExpense `json:"expenses"`
+}
+
+// GetTripParticipantStatsResponse defines model for GetTripParticipantStatsResponse.
+type GetTripParticipantStatsResponse struct {
+	Confirmed int64 `json:"confirmed"`
+
+	// Confirmed participants plus the companions they bring.
+	Headcount int64 `json:"headcount"`
+	Invited   int64 `json:"invited"`
 }
 
 // GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
 type GetTripParticipantsResponse struct {
+	// Left out on the last page.
+	NextCursor   *string                            `json:"next_cursor,omitempty"`
 	Participants []GetTripParticipantsResponseArray `json:"participants"`
 }
 
 // GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
 type GetTripParticipantsResponseArray struct {
+	// The participant Gravatar, absent when avatars are turned off.
+	AvatarURL   string              `json:"avatar_url,omitempty"`
 	Email       openapi_types.Email `json:"email"`
+	ExpiresAt   *time.Time          `json:"expires_at"`
 	ID          string              `json:"id"`
 	IsConfirmed bool                `json:"is_confirmed"`
-	Name        *string             `json:"name"`
+
+	// The invite is still pending and can no longer be confirmed.
+	IsExpired bool    `json:"is_expired"`
+	Name      *string `json:"name"`
+	PlusOnes  int     `json:"plus_ones"`
+}
+
+// GetTripSummaryResponse defines model for GetTripSummaryResponse.
+type GetTripSummaryResponse struct {
+	LinksCount int64 `json:"links_count"`
+}
+
+// GetTripsResponse defines model for GetTripsResponse.
+type GetTripsResponse struct {
+	Trips []GetTripDetailsResponseTripObj `json:"trips"`
 }
 
 // InviteParticipantRequest defines model for InviteParticipantRequest.
 type InviteParticipantRequest struct {
 	Email openapi_types.Email `json:"email" validate:"required,email"`
-}
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+	// A personal note shown in the invite e-mail, instead of the trip one.
+	InviteMessage string `json:"invite_message,omitempty" validate:"omitempty,max=500"`
+
+	// Companions the participant brings along.
+	PlusOnes int `json:"plus_ones,omitempty" validate:"min=0"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// InviteParticipantResponse defines model for InviteParticipantResponse.
+type InviteParticipantResponse struct {
+	// The address is on the suppression list, the invite e-mail won't be sent.
+	Suppressed bool `json:"suppressed"`
+}
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+// ListEmailSuppressionsResponse defines model for ListEmailSuppressionsResponse.
+type ListEmailSuppressionsResponse struct {
+	Suppressions []EmailSuppression `json:"suppressions"`
+}
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+// ListTripsResponse defines model for ListTripsResponse.
+type ListTripsResponse struct {
+	// Left out on the last page.
+	NextCursor *string                         `json:"next_cursor,omitempty"`
+	Trips      []GetTripDetailsResponseTripObj `json:"trips"`
+}
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+// MarkChecklistItemDoneRequest defines model for MarkChecklistItemDoneRequest.
+type MarkChecklistItemDoneRequest struct {
+	Done bool `json:"done"`
+}
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+// ParticipantActivity defines model for ParticipantActivity.
+type ParticipantActivity struct {
+	Activity    GetTripActivitiesResponseInnerArray `json:"activity"`
+	Destination string                              `json:"destination"`
+	TripID      string                              `json:"trip_id"`
+}
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+// PinActivityRequest defines model for PinActivityRequest.
+type PinActivityRequest struct {
+	Pinned bool `json:"pinned"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	// Mail delivery state, degraded while the mailer circuit is open. It doesn't affect readiness.
+	Mail   string `json:"mail,omitempty"`
+	Status string `json:"status"`
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// ScheduleIssue defines model for ScheduleIssue.
+type ScheduleIssue struct {
+	// The two activities involved, in time order.
+	ActivityIds []string `json:"activity_ids"`
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// The day of the second activity, in the trip time zone.
+	Date   openapi_types.Date `json:"date"`
+	EndsAt time.Time          `json:"ends_at"`
+
+	// How long the overlap or the gap lasts.
+	Minutes  int               `json:"minutes"`
+	StartsAt time.Time         `json:"starts_at"`
+	Type     ScheduleIssueType `json:"type"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+// TestEmailRequest defines model for TestEmailRequest.
+type TestEmailRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// TestEmailResponse defines model for TestEmailResponse.
+type TestEmailResponse struct {
+	// The mail server error, verbatim, when the send failed.
+	Error string `json:"error,omitempty"`
+	Sent  bool   `json:"sent"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// TripDateOption defines model for TripDateOption.
+type TripDateOption struct {
+	EndsAt   time.Time `json:"ends_at"`
+	ID       string    `json:"id"`
+	StartsAt time.Time `json:"starts_at"`
+	Votes    int       `json:"votes"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// TripDateOptionInput defines model for TripDateOptionInput.
+type TripDateOptionInput struct {
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	EndsAt time.Time `json:"ends_at" validate:"required"`
+
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	StartsAt time.Time `json:"starts_at" validate:"required"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// TripExpense defines model for TripExpense.
+type TripExpense struct {
+	ActivityID  string    `json:"activity_id,omitempty"`
+	Amount      int64     `json:"amount"`
+	CreatedAt   time.Time `json:"created_at"`
+	Currency    string    `json:"currency"`
+	Description string    `json:"description"`
+	ID          string    `json:"id"`
+	PayerID     string    `json:"payer_id"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// TripHoliday defines model for TripHoliday.
+type TripHoliday struct {
+	Date openapi_types.Date `json:"date"`
+	Name string             `json:"name"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// UpdateLinkRequest defines model for UpdateLinkRequest.
+type UpdateLinkRequest struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required,url"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	Destination string `json:"destination" validate:"required,min=4"`
+
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	EndsAt time.Time `json:"ends_at" validate:"required"`
+
+	// A personal note shown in the invite e-mails, omit it to remove the note.
+	InviteMessage string `json:"invite_message,omitempty" validate:"omitempty,max=500"`
+
+	// Where replies to the trip e-mails go, omit it to use the owner e-mail again.
+	ReplyTo openapi_types.Email `json:"reply_to,omitempty" validate:"omitempty,email"`
+
+	// Participants can confirm until then, it must be in the future and before starts_at. Omit it to remove the deadline. Once passed it can't be changed anymore.
+	RsvpDeadline time.Time `json:"rsvp_deadline,omitempty"`
+
+	// An RFC3339 timestamp, or a date like 2025-07-10 read as midnight UTC.
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+
+	// The version the update is based on, as read from the trip details.
+	Version int32 `json:"version" validate:"required,min=1"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// ValidateScheduleResponse defines model for ValidateScheduleResponse.
+type ValidateScheduleResponse struct {
+	// The idle time the gaps were checked against.
+	GapMinutes int `json:"gap_minutes"`
+
+	// Sorted by time, empty for a clean schedule.
+	Issues []ScheduleIssue `json:"issues"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// VersionConflictResponse defines model for VersionConflictResponse.
+type VersionConflictResponse struct {
+	Message string `json:"message"`
+	Version int32  `json:"version"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// VersionResponse defines model for VersionResponse.
+type VersionResponse struct {
+	BuildDate string `json:"build_date"`
+	Commit    string `json:"commit"`
+	Version   string `json:"version"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// VoteDateOptionRequest defines model for VoteDateOptionRequest.
+type VoteDateOptionRequest struct {
+	// The participant ID from the invite link.
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// ActivityRecurrenceFrequency defines model for ActivityRecurrence.Frequency.
+type ActivityRecurrenceFrequency struct {
+	value string
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *ActivityRecurrenceFrequency) ToValue() string {
+	return t.value
+}
+func (t ActivityRecurrenceFrequency) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ActivityRecurrenceFrequency) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ActivityRecurrenceFrequency) FromValue(value string) error {
+	switch value {
+
+	case ActivityRecurrenceFrequencyDaily.value:
+		t.value = value
+		return nil
+
+	case ActivityRecurrenceFrequencyWeekly.value:
+		t.value = value
+		return nil
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// BatchRequestItemMethod defines model for BatchRequestItem.Method.
+type BatchRequestItemMethod struct {
+	value string
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+func (t *BatchRequestItemMethod) ToValue() string {
+	return t.value
+}
+func (t BatchRequestItemMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *BatchRequestItemMethod) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *BatchRequestItemMethod) FromValue(value string) error {
+	switch value {
+
+	case BatchRequestItemMethodPost.value:
+		t.value = value
+		return nil
+
+	case BatchRequestItemMethodPut.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// Bounces are added by the mailer when the server refuses the recipient for good.
+type EmailSuppressionReason struct {
+	value string
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *EmailSuppressionReason) ToValue() string {
+	return t.value
+}
+func (t EmailSuppressionReason) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *EmailSuppressionReason) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *EmailSuppressionReason) FromValue(value string) error {
+	switch value {
+
+	case EmailSuppressionReasonBounce.value:
+		t.value = value
+		return nil
+
+	case EmailSuppressionReasonComplaint.value:
+		t.value = value
+		return nil
+
+	case EmailSuppressionReasonManual.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// ScheduleIssueType defines model for ScheduleIssue.Type.
+type ScheduleIssueType struct {
+	value string
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+func (t *ScheduleIssueType) ToValue() string {
+	return t.value
+}
+func (t ScheduleIssueType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ScheduleIssueType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ScheduleIssueType) FromValue(value string) error {
+	switch value {
+
+	case ScheduleIssueTypeGap.value:
+		t.value = value
+		return nil
+
+	case ScheduleIssueTypeOverlap.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// PostActivitiesBatchJSONBody defines parameters for PostActivitiesBatch.
+type PostActivitiesBatchJSONBody GetActivitiesBatchRequest
+
+// PostAdminEmailsTestJSONBody defines parameters for PostAdminEmailsTest.
+type PostAdminEmailsTestJSONBody TestEmailRequest
+
+// PostAdminSuppressionsJSONBody defines parameters for PostAdminSuppressions.
+type PostAdminSuppressionsJSONBody CreateEmailSuppressionRequest
+
+// GetAdminTripsParams defines parameters for GetAdminTrips.
+type GetAdminTripsParams struct {
+	Limit  *int    `json:"limit,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+
+	// Comma separated trip fields to return, like id,destination,starts_at. The others are left out.
+	Fields *string `json:"fields,omitempty"`
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// PostBatchJSONBody defines parameters for PostBatch.
+type PostBatchJSONBody BatchRequest
+
+// GetParticipantsActivitiesParams defines parameters for GetParticipantsActivities.
+type GetParticipantsActivitiesParams struct {
+	Email string `json:"email"`
+
+	// Set to true to list the activities that already happened too.
+	IncludePast *bool `json:"include_past,omitempty"`
+	Limit       *int  `json:"limit,omitempty"`
+
+	// Deprecated, use cursor. Can't be combined with it.
+	Offset *int `json:"offset,omitempty"`
+
+	// The next_cursor of the previous page.
+	Cursor *string `json:"cursor,omitempty"`
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// PatchParticipantsParticipantIDConfirmJSONBody defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmJSONBody ConfirmParticipantRequest
+
+// PatchParticipantsParticipantIDEmailJSONBody defines parameters for PatchParticipantsParticipantIDEmail.
+type PatchParticipantsParticipantIDEmailJSONBody ChangeParticipantEmailRequest
+
+// GetTripsParams defines parameters for GetTrips.
+type GetTripsParams struct {
+	// Comma separated trip IDs, at most 50. Unknown trips are left out of the response.
+	Ids string `json:"ids"`
+
+	// Comma separated trip fields to return, like id,destination,starts_at. The others are left out.
+	Fields *string `json:"fields,omitempty"`
 }
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// GetTripsTripIDParams defines parameters for GetTripsTripID.
+type GetTripsTripIDParams struct {
+	// Adds human readable dates in this locale, overriding Accept-Language.
+	Locale *string `json:"locale,omitempty"`
+
+	// Comma separated trip fields to return, like id,destination,starts_at. The others are left out.
+	Fields *string `json:"fields,omitempty"`
 }
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// PutTripsTripIDParams defines parameters for PutTripsTripID.
+type PutTripsTripIDParams struct {
+	// Set to false to skip the trip updated e-mail to confirmed participants, like when fixing a typo.
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Only the activities whose title contains this text, ignoring case and accents.
+	Q *string `json:"q,omitempty"`
+
+	// Adds human readable dates in this locale, overriding Accept-Language.
+	Locale *string `json:"locale,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// DeleteTripsTripIDActivitiesActivityIDParams defines parameters for DeleteTripsTripIDActivitiesActivityID.
+type DeleteTripsTripIDActivitiesActivityIDParams struct {
+	// group applies the change to every occurrence of a recurring activity, occurrence (the default) only to the given one.
+	Scope *DeleteTripsTripIDActivitiesActivityIDParamsScope `json:"scope,omitempty"`
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParamsScope defines parameters for DeleteTripsTripIDActivitiesActivityID.
+type DeleteTripsTripIDActivitiesActivityIDParamsScope string
+
+// PatchTripsTripIDActivitiesActivityIDPinJSONBody defines parameters for PatchTripsTripIDActivitiesActivityIDPin.
+type PatchTripsTripIDActivitiesActivityIDPinJSONBody PinActivityRequest
+
+// PatchTripsTripIDActivitiesActivityIDPinParams defines parameters for PatchTripsTripIDActivitiesActivityIDPin.
+type PatchTripsTripIDActivitiesActivityIDPinParams struct {
+	// group applies the change to every occurrence of a recurring activity, occurrence (the default) only to the given one.
+	Scope *PatchTripsTripIDActivitiesActivityIDPinParamsScope `json:"scope,omitempty"`
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinParamsScope defines parameters for PatchTripsTripIDActivitiesActivityIDPin.
+type PatchTripsTripIDActivitiesActivityIDPinParamsScope string
+
+// PostTripsTripIDChecklistJSONBody defines parameters for PostTripsTripIDChecklist.
+type PostTripsTripIDChecklistJSONBody CreateChecklistItemRequest
+
+// PatchTripsTripIDChecklistItemIDAssigneeJSONBody defines parameters for PatchTripsTripIDChecklistItemIDAssignee.
+type PatchTripsTripIDChecklistItemIDAssigneeJSONBody AssignChecklistItemRequest
+
+// PatchTripsTripIDChecklistItemIDDoneJSONBody defines parameters for PatchTripsTripIDChecklistItemIDDone.
+type PatchTripsTripIDChecklistItemIDDoneJSONBody MarkChecklistItemDoneRequest
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSONBody defines parameters for PostTripsTripIDDateOptionsOptionIDVote.
+type PostTripsTripIDDateOptionsOptionIDVoteJSONBody VoteDateOptionRequest
+
+// PostTripsTripIDExpensesJSONBody defines parameters for PostTripsTripIDExpenses.
+type PostTripsTripIDExpensesJSONBody CreateExpenseRequest
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PatchTripsTripIDOwnerEmailJSONBody defines parameters for PatchTripsTripIDOwnerEmail.
+type PatchTripsTripIDOwnerEmailJSONBody ChangeOwnerEmailRequest
+
+// GetTripsTripIDOwnerEmailVerifyParams defines parameters for GetTripsTripIDOwnerEmailVerify.
+type GetTripsTripIDOwnerEmailVerifyParams struct {
+	// The token sent to the new address.
+	Token string `json:"token"`
+}
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	Q     *string `json:"q,omitempty"`
+	Limit *int    `json:"limit,omitempty"`
+
+	// Deprecated, use cursor. Can't be combined with it.
+	Offset *int `json:"offset,omitempty"`
+
+	// The next_cursor of the previous page.
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// GetTripsTripIDScheduleValidateParams defines parameters for GetTripsTripIDScheduleValidate.
+type GetTripsTripIDScheduleValidateParams struct {
+	// Idle minutes between two activities of a day reported as a gap, 180 by default.
+	GapMinutes *int `json:"gap_minutes,omitempty"`
+}
+
+// GetUnsubscribeParams defines parameters for GetUnsubscribe.
+type GetUnsubscribeParams struct {
+	Token string `json:"token"`
+}
+
+// PostActivitiesBatchJSONRequestBody defines body for PostActivitiesBatch for application/json ContentType.
+type PostActivitiesBatchJSONRequestBody PostActivitiesBatchJSONBody
+
+// Bind implements render.Binder.
+func (PostActivitiesBatchJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostAdminEmailsTestJSONRequestBody defines body for PostAdminEmailsTest for application/json ContentType.
+type PostAdminEmailsTestJSONRequestBody PostAdminEmailsTestJSONBody
+
+// Bind implements render.Binder.
+func (PostAdminEmailsTestJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostAdminSuppressionsJSONRequestBody defines body for PostAdminSuppressions for application/json ContentType.
+type PostAdminSuppressionsJSONRequestBody PostAdminSuppressionsJSONBody
+
+// Bind implements render.Binder.
+func (PostAdminSuppressionsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostBatchJSONRequestBody defines body for PostBatch for application/json ContentType.
+type PostBatchJSONRequestBody PostBatchJSONBody
+
+// Bind implements render.Binder.
+func (PostBatchJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDConfirmJSONRequestBody defines body for PatchParticipantsParticipantIDConfirm for application/json ContentType.
+type PatchParticipantsParticipantIDConfirmJSONRequestBody PatchParticipantsParticipantIDConfirmJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDConfirmJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDEmailJSONRequestBody defines body for PatchParticipantsParticipantIDEmail for application/json ContentType.
+type PatchParticipantsParticipantIDEmailJSONRequestBody PatchParticipantsParticipantIDEmailJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDEmailJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityIDPin for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDPinJSONRequestBody PatchTripsTripIDActivitiesActivityIDPinJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesActivityIDPinJSONRequestBody) Bind(*http.Request) error {
+	return nil
 }
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// PostTripsTripIDChecklistJSONRequestBody defines body for PostTripsTripIDChecklist for application/json ContentType.
+type PostTripsTripIDChecklistJSONRequestBody PostTripsTripIDChecklistJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDChecklistItemIDAssigneeJSONRequestBody defines body for PatchTripsTripIDChecklistItemIDAssignee for application/json ContentType.
+type PatchTripsTripIDChecklistItemIDAssigneeJSONRequestBody PatchTripsTripIDChecklistItemIDAssigneeJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDChecklistItemIDAssigneeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDChecklistItemIDDoneJSONRequestBody defines body for PatchTripsTripIDChecklistItemIDDone for application/json ContentType.
+type PatchTripsTripIDChecklistItemIDDoneJSONRequestBody PatchTripsTripIDChecklistItemIDDoneJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDChecklistItemIDDoneJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSONRequestBody defines body for PostTripsTripIDDateOptionsOptionIDVote for application/json ContentType.
+type PostTripsTripIDDateOptionsOptionIDVoteJSONRequestBody PostTripsTripIDDateOptionsOptionIDVoteJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDDateOptionsOptionIDVoteJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesJSONRequestBody defines body for PostTripsTripIDExpenses for application/json ContentType.
+type PostTripsTripIDExpensesJSONRequestBody PostTripsTripIDExpensesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDOwnerEmailJSONRequestBody defines body for PatchTripsTripIDOwnerEmail for application/json ContentType.
+type PatchTripsTripIDOwnerEmailJSONRequestBody PatchTripsTripIDOwnerEmailJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDOwnerEmailJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// PostActivitiesBatchJSON200Response is a constructor method for a PostActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesBatchJSON200Response(body GetActivitiesBatchResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesBatchJSON400Response is a constructor method for a PostActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesBatchJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminEmailsDeadLetterJSON200Response is a constructor method for a GetAdminEmailsDeadLetter response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminEmailsDeadLetterJSON200Response(body GetDeadLetterEmailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminEmailsDeadLetterJSON400Response is a constructor method for a GetAdminEmailsDeadLetter response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminEmailsDeadLetterJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminEmailsTestJSON200Response is a constructor method for a PostAdminEmailsTest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminEmailsTestJSON200Response(body TestEmailResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminEmailsTestJSON400Response is a constructor method for a PostAdminEmailsTest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminEmailsTestJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminEmailsEmailIDRequeueJSON204Response is a constructor method for a PostAdminEmailsEmailIDRequeue response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminEmailsEmailIDRequeueJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminEmailsEmailIDRequeueJSON400Response is a constructor method for a PostAdminEmailsEmailIDRequeue response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminEmailsEmailIDRequeueJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminEmailsEmailIDRequeueJSON404Response is a constructor method for a PostAdminEmailsEmailIDRequeue response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminEmailsEmailIDRequeueJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsJSON200Response is a constructor method for a GetAdminStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsJSON200Response(body GetGlobalStatsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminSuppressionsJSON200Response is a constructor method for a GetAdminSuppressions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminSuppressionsJSON200Response(body ListEmailSuppressionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminSuppressionsJSON201Response is a constructor method for a PostAdminSuppressions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminSuppressionsJSON201Response(body EmailSuppression) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminSuppressionsJSON400Response is a constructor method for a PostAdminSuppressions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminSuppressionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteAdminSuppressionsEmailJSON204Response is a constructor method for a DeleteAdminSuppressionsEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteAdminSuppressionsEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteAdminSuppressionsEmailJSON400Response is a constructor method for a DeleteAdminSuppressionsEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteAdminSuppressionsEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteAdminSuppressionsEmailJSON404Response is a constructor method for a DeleteAdminSuppressionsEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteAdminSuppressionsEmailJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminTripsJSON200Response is a constructor method for a GetAdminTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminTripsJSON200Response(body ListTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminTripsJSON400Response is a constructor method for a GetAdminTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostBatchJSON200Response is a constructor method for a PostBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostBatchJSON200Response(body BatchResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostBatchJSON400Response is a constructor method for a PostBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostBatchJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsActivitiesJSON200Response is a constructor method for a GetParticipantsActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsActivitiesJSON200Response(body GetParticipantActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsActivitiesJSON400Response is a constructor method for a GetParticipantsActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON410Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDEmailJSON204Response is a constructor method for a PatchParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDEmailJSON400Response is a constructor method for a PatchParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDEmailJSON404Response is a constructor method for a PatchParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDEmailJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDEmailJSON409Response is a constructor method for a PatchParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDEmailJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDExtendJSON200Response is a constructor method for a PostParticipantsParticipantIDExtend response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDExtendJSON200Response(body ExtendInviteResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDExtendJSON400Response is a constructor method for a PostParticipantsParticipantIDExtend response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDExtendJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDExtendJSON404Response is a constructor method for a PostParticipantsParticipantIDExtend response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDExtendJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDStatusJSON200Response is a constructor method for a GetParticipantsParticipantIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDStatusJSON200Response(body GetParticipantStatusResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDStatusJSON400Response is a constructor method for a GetParticipantsParticipantIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDStatusJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDStatusJSON404Response is a constructor method for a GetParticipantsParticipantIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDStatusJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetReadyzJSON200Response is a constructor method for a GetReadyz response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetReadyzJSON200Response(body ReadinessResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetReadyzJSON503Response is a constructor method for a GetReadyz response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetReadyzJSON503Response(body ReadinessResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        503,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON200Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON200Response(body GetTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON400Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON409Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON409Response(body VersionConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON409Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesGeojsonJSON400Response is a constructor method for a GetTripsTripIDActivitiesGeojson response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesGeojsonJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesGeojsonJSON404Response is a constructor method for a GetTripsTripIDActivitiesGeojson response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesGeojsonJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDedupeJSON200Response is a constructor method for a PostTripsTripIDActivitiesDedupe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDedupeJSON200Response(body DedupeActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDedupeJSON400Response is a constructor method for a PostTripsTripIDActivitiesDedupe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDedupeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDedupeJSON404Response is a constructor method for a PostTripsTripIDActivitiesDedupe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDedupeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDedupeJSON409Response is a constructor method for a PostTripsTripIDActivitiesDedupe response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDedupeJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDuplicatesJSON200Response is a constructor method for a GetTripsTripIDActivitiesDuplicates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDuplicatesJSON200Response(body GetDuplicateActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDuplicatesJSON400Response is a constructor method for a GetTripsTripIDActivitiesDuplicates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDuplicatesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDuplicatesJSON404Response is a constructor method for a GetTripsTripIDActivitiesDuplicates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDuplicatesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesStatsJSON200Response is a constructor method for a GetTripsTripIDActivitiesStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesStatsJSON200Response(body GetTripActivityStatsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesStatsJSON400Response is a constructor method for a GetTripsTripIDActivitiesStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesStatsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesStatsJSON404Response is a constructor method for a GetTripsTripIDActivitiesStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesStatsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesTodayJSON200Response is a constructor method for a GetTripsTripIDActivitiesToday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesTodayJSON200Response(body GetTodayActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesTodayJSON400Response is a constructor method for a GetTripsTripIDActivitiesToday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesTodayJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesTodayJSON404Response is a constructor method for a GetTripsTripIDActivitiesToday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesTodayJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDPin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDPinJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDPin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDPinJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDPin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDPinJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDPinJSON409Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDPin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDPinJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON200Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON200Response(body GetTripChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON400Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON404Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON201Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON201Response(body CreateChecklistItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON400Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON404Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON400Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON404Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDAssigneeJSON204Response is a constructor method for a PatchTripsTripIDChecklistItemIDAssignee response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDAssigneeJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDAssigneeJSON400Response is a constructor method for a PatchTripsTripIDChecklistItemIDAssignee response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDAssigneeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDAssigneeJSON404Response is a constructor method for a PatchTripsTripIDChecklistItemIDAssignee response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDAssigneeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDDoneJSON204Response is a constructor method for a PatchTripsTripIDChecklistItemIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDDoneJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDDoneJSON400Response is a constructor method for a PatchTripsTripIDChecklistItemIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDDoneJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDChecklistItemIDDoneJSON404Response is a constructor method for a PatchTripsTripIDChecklistItemIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDChecklistItemIDDoneJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateOptionsJSON200Response is a constructor method for a GetTripsTripIDDateOptions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateOptionsJSON200Response(body GetTripDateOptionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateOptionsJSON400Response is a constructor method for a GetTripsTripIDDateOptions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateOptionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateOptionsJSON404Response is a constructor method for a GetTripsTripIDDateOptions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateOptionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelectJSON204Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDSelect response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDSelectJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelectJSON400Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDSelect response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDSelectJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelectJSON404Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDSelect response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDSelectJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelectJSON409Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDSelect response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDSelectJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSON204Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDVoteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSON400Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDVoteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSON404Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDVoteJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSON409Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDVoteJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDateOptionsOptionIDVoteJSON410Response is a constructor method for a PostTripsTripIDDateOptionsOptionIDVote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDateOptionsOptionIDVoteJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEventsJSON400Response is a constructor method for a GetTripsTripIDEvents response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEventsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEventsJSON404Response is a constructor method for a GetTripsTripIDEvents response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEventsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON200Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON200Response(body GetTripExpensesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON400Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON404Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON201Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON400Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON404Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON409Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSummaryJSON200Response is a constructor method for a GetTripsTripIDExpensesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSummaryJSON200Response(body GetTripExpenseSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSummaryJSON400Response is a constructor method for a GetTripsTripIDExpensesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSummaryJSON404Response is a constructor method for a GetTripsTripIDExpensesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSummaryJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSummaryJSON409Response is a constructor method for a GetTripsTripIDExpensesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSummaryJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON404Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body InviteParticipantResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON200Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON200Response(body GetLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON400Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON404Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerEmailJSON202Response is a constructor method for a PatchTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerEmailJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerEmailJSON400Response is a constructor method for a PatchTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerEmailJSON404Response is a constructor method for a PatchTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerEmailJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerEmailVerifyJSON204Response is a constructor method for a GetTripsTripIDOwnerEmailVerify response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerEmailVerifyJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerEmailVerifyJSON400Response is a constructor method for a GetTripsTripIDOwnerEmailVerify response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerEmailVerifyJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerEmailVerifyJSON404Response is a constructor method for a GetTripsTripIDOwnerEmailVerify response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerEmailVerifyJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerEmailVerifyJSON410Response is a constructor method for a GetTripsTripIDOwnerEmailVerify response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerEmailVerifyJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsStatsJSON200Response is a constructor method for a GetTripsTripIDParticipantsStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsStatsJSON200Response(body GetTripParticipantStatsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsStatsJSON400Response is a constructor method for a GetTripsTripIDParticipantsStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsStatsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsStatsJSON404Response is a constructor method for a GetTripsTripIDParticipantsStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsStatsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON409Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON429Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPublishJSON204Response is a constructor method for a PostTripsTripIDPublish response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPublishJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPublishJSON400Response is a constructor method for a PostTripsTripIDPublish response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPublishJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPublishJSON404Response is a constructor method for a PostTripsTripIDPublish response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPublishJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDScheduleValidateJSON200Response is a constructor method for a GetTripsTripIDScheduleValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDScheduleValidateJSON200Response(body ValidateScheduleResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDScheduleValidateJSON400Response is a constructor method for a GetTripsTripIDScheduleValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDScheduleValidateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDScheduleValidateJSON404Response is a constructor method for a GetTripsTripIDScheduleValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDScheduleValidateJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSummaryJSON200Response is a constructor method for a GetTripsTripIDSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSummaryJSON200Response(body GetTripSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSummaryJSON400Response is a constructor method for a GetTripsTripIDSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSummaryJSON404Response is a constructor method for a GetTripsTripIDSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSummaryJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetVersionJSON200Response is a constructor method for a GetVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetVersionJSON200Response(body VersionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the activities of several trips at once.
+	// (POST /activities/batch)
+	PostActivitiesBatch(w http.ResponseWriter, r *http.Request) *Response
+	// List the e-mails that exhausted their retries.
+	// (GET /admin/emails/dead-letter)
+	GetAdminEmailsDeadLetter(w http.ResponseWriter, r *http.Request) *Response
+	// Send a test e-mail through the configured mail server.
+	// (POST /admin/emails/test)
+	PostAdminEmailsTest(w http.ResponseWriter, r *http.Request) *Response
+	// Send a dead letter e-mail again.
+	// (POST /admin/emails/{emailId}/requeue)
+	PostAdminEmailsEmailIDRequeue(w http.ResponseWriter, r *http.Request, emailID string) *Response
+	// Get the totals across every trip.
+	// (GET /admin/stats)
+	GetAdminStats(w http.ResponseWriter, r *http.Request) *Response
+	// List the addresses no e-mail is sent to.
+	// (GET /admin/suppressions)
+	GetAdminSuppressions(w http.ResponseWriter, r *http.Request) *Response
+	// Stop sending e-mails to an address.
+	// (POST /admin/suppressions)
+	PostAdminSuppressions(w http.ResponseWriter, r *http.Request) *Response
+	// Send e-mails to a suppressed address again.
+	// (DELETE /admin/suppressions/{email})
+	DeleteAdminSuppressionsEmail(w http.ResponseWriter, r *http.Request, email string) *Response
+	// List every trip, page by page.
+	// (GET /admin/trips)
+	GetAdminTrips(w http.ResponseWriter, r *http.Request, params GetAdminTripsParams) *Response
+	// Run several trip, activity and link writes in one request.
+	// (POST /batch)
+	PostBatch(w http.ResponseWriter, r *http.Request) *Response
+	// List the activities of the trips a participant confirmed.
+	// (GET /participants/activities)
+	GetParticipantsActivities(w http.ResponseWriter, r *http.Request, params GetParticipantsActivitiesParams) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Correct a participant e-mail and send the invite again.
+	// (PATCH /participants/{participantId}/email)
+	PatchParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Extend an expired or expiring invite and send it again.
+	// (POST /participants/{participantId}/extend)
+	PostParticipantsParticipantIDExtend(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get a participant confirmation state and the trip details, without confirming.
+	// (GET /participants/{participantId}/status)
+	GetParticipantsParticipantIDStatus(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Report whether the API is ready to serve traffic.
+	// (GET /readyz)
+	GetReadyz(w http.ResponseWriter, r *http.Request) *Response
+	// Get the details of several trips at once.
+	// (GET /trips)
+	GetTrips(w http.ResponseWriter, r *http.Request, params GetTripsParams) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParams) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDParams) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the activities of a trip as a GeoJSON feature collection.
+	// (GET /trips/{tripId}/activities.geojson)
+	GetTripsTripIDActivitiesGeojson(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete duplicated activities, keeping the earliest created of each group.
+	// (POST /trips/{tripId}/activities/dedupe)
+	PostTripsTripIDActivitiesDedupe(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the groups of activities sharing the same title and time.
+	// (GET /trips/{tripId}/activities/duplicates)
+	GetTripsTripIDActivitiesDuplicates(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get aggregate statistics of a trip activities.
+	// (GET /trips/{tripId}/activities/stats)
+	GetTripsTripIDActivitiesStats(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the activities of today in the trip time zone.
+	// (GET /trips/{tripId}/activities/today)
+	GetTripsTripIDActivitiesToday(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete an activity, or every occurrence of a recurring one.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params DeleteTripsTripIDActivitiesActivityIDParams) *Response
+	// Pin or unpin an activity.
+	// (PATCH /trips/{tripId}/activities/{activityId}/pin)
+	PatchTripsTripIDActivitiesActivityIDPin(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PatchTripsTripIDActivitiesActivityIDPinParams) *Response
+	// Get the trip checklist grouped by assignee.
+	// (GET /trips/{tripId}/checklist)
+	GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add an item to the trip checklist.
+	// (POST /trips/{tripId}/checklist)
+	PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a checklist item.
+	// (DELETE /trips/{tripId}/checklist/{itemId})
+	DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Assign a checklist item to a participant or the owner, or unassign it.
+	// (PATCH /trips/{tripId}/checklist/{itemId}/assignee)
+	PatchTripsTripIDChecklistItemIDAssignee(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Mark a checklist item done or not done.
+	// (PATCH /trips/{tripId}/checklist/{itemId}/done)
+	PatchTripsTripIDChecklistItemIDDone(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the trip date options with their votes.
+	// (GET /trips/{tripId}/date-options)
+	GetTripsTripIDDateOptions(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Select a date option as the trip dates.
+	// (POST /trips/{tripId}/date-options/{optionId}/select)
+	PostTripsTripIDDateOptionsOptionIDSelect(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *Response
+	// Vote on a date option of the trip.
+	// (POST /trips/{tripId}/date-options/{optionId}/vote)
+	PostTripsTripIDDateOptionsOptionIDVote(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *Response
+	// Stream the changes to a trip as server-sent events.
+	// (GET /trips/{tripId}/events)
+	GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the expenses of a trip, oldest first.
+	// (GET /trips/{tripId}/expenses)
+	GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Log a shared cost of the trip.
+	// (POST /trips/{tripId}/expenses)
+	PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get what each participant paid and owes, splitting the expenses equally between the confirmed participants.
+	// (GET /trips/{tripId}/expenses/summary)
+	GetTripsTripIDExpensesSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete an expense.
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a single trip link.
+	// (GET /trips/{tripId}/links/{linkId})
+	GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Update a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Change the trip owner e-mail.
+	// (PATCH /trips/{tripId}/owner-email)
+	PatchTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Verify a new trip owner e-mail and make it the owner address.
+	// (GET /trips/{tripId}/owner-email/verify)
+	GetTripsTripIDOwnerEmailVerify(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDOwnerEmailVerifyParams) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+	// Get how many people are invited and coming to a trip.
+	// (GET /trips/{tripId}/participants/stats)
+	GetTripsTripIDParticipantsStats(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a participant from a trip.
+	// (DELETE /trips/{tripId}/participants/{participantId})
+	DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Send the invite e-mail of a pending participant again.
+	// (POST /trips/{tripId}/participants/{participantId}/resend-invite)
+	PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Publish a draft trip and send the owner confirmation e-mail.
+	// (POST /trips/{tripId}/publish)
+	PostTripsTripIDPublish(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Check a trip schedule for overlapping activities and idle gaps.
+	// (GET /trips/{tripId}/schedule/validate)
+	GetTripsTripIDScheduleValidate(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDScheduleValidateParams) *Response
+	// Get an overview of a trip.
+	// (GET /trips/{tripId}/summary)
+	GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Stop the optional e-mails of a category, from the link they carry.
+	// (GET /unsubscribe)
+	GetUnsubscribe(w http.ResponseWriter, r *http.Request, params GetUnsubscribeParams) *Response
+	// Get the version of the running build.
+	// (GET /version)
+	GetVersion(w http.ResponseWriter, r *http.Request) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// PostActivitiesBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostActivitiesBatch(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminEmailsDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminEmailsDeadLetter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminEmailsDeadLetter(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminEmailsTest operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminEmailsTest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminEmailsTest(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminEmailsEmailIDRequeue operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminEmailsEmailIDRequeue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "emailId" -------------
+	var emailID string
+
+	if err := runtime.BindStyledParameter("simple", false, "emailId", chi.URLParam(r, "emailId"), &emailID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "emailId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminEmailsEmailIDRequeue(w, r, emailID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminStats(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminSuppressions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSuppressions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminSuppressions(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminSuppressions operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminSuppressions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminSuppressions(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteAdminSuppressionsEmail operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminSuppressionsEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "email" -------------
+	var email string
+
+	if err := runtime.BindStyledParameter("simple", false, "email", chi.URLParam(r, "email"), &email); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteAdminSuppressionsEmail(w, r, email)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminTripsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostBatch(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsActivitiesParams
+
+	// ------------- Required query parameter "email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "email", r.URL.Query(), &params.Email); err != nil {
+		err = fmt.Errorf("invalid format for parameter email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "email"})
+		return
+	}
+
+	// ------------- Optional query parameter "include_past" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "include_past", r.URL.Query(), &params.IncludePast); err != nil {
+		err = fmt.Errorf("invalid format for parameter include_past: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "include_past"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset); err != nil {
+		err = fmt.Errorf("invalid format for parameter offset: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "offset"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsActivities(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDEmail operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDEmail(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDExtend operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDExtend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDExtend(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDStatus(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetReadyz operation middleware
+func (siw *ServerInterfaceWrapper) GetReadyz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetReadyz(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsParams
+
+	// ------------- Required query parameter "ids" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "ids", r.URL.Query(), &params.Ids); err != nil {
+		err = fmt.Errorf("invalid format for parameter ids: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "ids"})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParams
+
+	// ------------- Optional query parameter "locale" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale); err != nil {
+		err = fmt.Errorf("invalid format for parameter locale: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "locale"})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "notify", r.URL.Query(), &params.Notify); err != nil {
+		err = fmt.Errorf("invalid format for parameter notify: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notify"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "q"})
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale); err != nil {
+		err = fmt.Errorf("invalid format for parameter locale: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "locale"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesGeojson operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesGeojson(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesGeojson(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesDedupe operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesDedupe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesDedupe(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesDuplicates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesDuplicates(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesStats(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesToday operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesToday(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesToday(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDActivitiesActivityIDParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope); err != nil {
+		err = fmt.Errorf("invalid format for parameter scope: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "scope"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityIDPin operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityIDPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDActivitiesActivityIDPinParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope); err != nil {
+		err = fmt.Errorf("invalid format for parameter scope: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "scope"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityIDPin(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklist operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklist(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklist operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklist(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDChecklistItemIDAssignee operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDChecklistItemIDAssignee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDChecklistItemIDAssignee(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDChecklistItemIDDone operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDChecklistItemIDDone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDChecklistItemIDDone(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDateOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDateOptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDateOptions(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDDateOptionsOptionIDSelect operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDDateOptionsOptionIDSelect(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "optionId" -------------
+	var optionID string
+
+	if err := runtime.BindStyledParameter("simple", false, "optionId", chi.URLParam(r, "optionId"), &optionID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "optionId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDDateOptionsOptionIDSelect(w, r, tripID, optionID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDDateOptionsOptionIDVote operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDDateOptionsOptionIDVote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "optionId" -------------
+	var optionID string
+
+	if err := runtime.BindStyledParameter("simple", false, "optionId", chi.URLParam(r, "optionId"), &optionID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "optionId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDDateOptionsOptionIDVote(w, r, tripID, optionID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDEvents(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
-}
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
-}
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.GetTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -519,12 +4511,28 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -537,8 +4545,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PatchTripsTripIDOwnerEmail operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -550,7 +4558,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.PatchTripsTripIDOwnerEmail(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -563,8 +4571,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDOwnerEmailVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDOwnerEmailVerify(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -575,8 +4583,19 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDOwnerEmailVerifyParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDOwnerEmailVerify(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -589,8 +4608,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -601,8 +4620,43 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "q"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset); err != nil {
+		err = fmt.Errorf("invalid format for parameter offset: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "offset"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -615,8 +4669,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipantsStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsStats(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -628,7 +4682,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipantsStats(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +4695,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -653,8 +4707,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDParticipantsParticipantID(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -667,8 +4729,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDParticipantsParticipantIDResendInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -679,8 +4741,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDParticipantsParticipantIDResendInvite(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -693,8 +4763,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDPublish operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPublish(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -706,7 +4776,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDPublish(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +4789,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDScheduleValidate operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDScheduleValidate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -731,8 +4801,19 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDScheduleValidateParams
+
+	// ------------- Optional query parameter "gap_minutes" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "gap_minutes", r.URL.Query(), &params.GapMinutes); err != nil {
+		err = fmt.Errorf("invalid format for parameter gap_minutes: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "gap_minutes"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDScheduleValidate(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -745,8 +4826,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -758,7 +4839,54 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) GetUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetUnsubscribe(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetVersion(w, r)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -886,17 +5014,65 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Post("/activities/batch", wrapper.PostActivitiesBatch)
+		r.Get("/admin/emails/dead-letter", wrapper.GetAdminEmailsDeadLetter)
+		r.Post("/admin/emails/test", wrapper.PostAdminEmailsTest)
+		r.Post("/admin/emails/{emailId}/requeue", wrapper.PostAdminEmailsEmailIDRequeue)
+		r.Get("/admin/stats", wrapper.GetAdminStats)
+		r.Get("/admin/suppressions", wrapper.GetAdminSuppressions)
+		r.Post("/admin/suppressions", wrapper.PostAdminSuppressions)
+		r.Delete("/admin/suppressions/{email}", wrapper.DeleteAdminSuppressionsEmail)
+		r.Get("/admin/trips", wrapper.GetAdminTrips)
+		r.Post("/batch", wrapper.PostBatch)
+		r.Get("/participants/activities", wrapper.GetParticipantsActivities)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Patch("/participants/{participantId}/email", wrapper.PatchParticipantsParticipantIDEmail)
+		r.Post("/participants/{participantId}/extend", wrapper.PostParticipantsParticipantIDExtend)
+		r.Get("/participants/{participantId}/status", wrapper.GetParticipantsParticipantIDStatus)
+		r.Get("/readyz", wrapper.GetReadyz)
+		r.Get("/trips", wrapper.GetTrips)
 		r.Post("/trips", wrapper.PostTrips)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Get("/trips/{tripId}/activities.geojson", wrapper.GetTripsTripIDActivitiesGeojson)
+		r.Post("/trips/{tripId}/activities/dedupe", wrapper.PostTripsTripIDActivitiesDedupe)
+		r.Get("/trips/{tripId}/activities/duplicates", wrapper.GetTripsTripIDActivitiesDuplicates)
+		r.Get("/trips/{tripId}/activities/stats", wrapper.GetTripsTripIDActivitiesStats)
+		r.Get("/trips/{tripId}/activities/today", wrapper.GetTripsTripIDActivitiesToday)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}/pin", wrapper.PatchTripsTripIDActivitiesActivityIDPin)
+		r.Get("/trips/{tripId}/checklist", wrapper.GetTripsTripIDChecklist)
+		r.Post("/trips/{tripId}/checklist", wrapper.PostTripsTripIDChecklist)
+		r.Delete("/trips/{tripId}/checklist/{itemId}", wrapper.DeleteTripsTripIDChecklistItemID)
+		r.Patch("/trips/{tripId}/checklist/{itemId}/assignee", wrapper.PatchTripsTripIDChecklistItemIDAssignee)
+		r.Patch("/trips/{tripId}/checklist/{itemId}/done", wrapper.PatchTripsTripIDChecklistItemIDDone)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/date-options", wrapper.GetTripsTripIDDateOptions)
+		r.Post("/trips/{tripId}/date-options/{optionId}/select", wrapper.PostTripsTripIDDateOptionsOptionIDSelect)
+		r.Post("/trips/{tripId}/date-options/{optionId}/vote", wrapper.PostTripsTripIDDateOptionsOptionIDVote)
+		r.Get("/trips/{tripId}/events", wrapper.GetTripsTripIDEvents)
+		r.Get("/trips/{tripId}/expenses", wrapper.GetTripsTripIDExpenses)
+		r.Post("/trips/{tripId}/expenses", wrapper.PostTripsTripIDExpenses)
+		r.Get("/trips/{tripId}/expenses/summary", wrapper.GetTripsTripIDExpensesSummary)
+		r.Delete("/trips/{tripId}/expenses/{expenseId}", wrapper.DeleteTripsTripIDExpensesExpenseID)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Get("/trips/{tripId}/links/{linkId}", wrapper.GetTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
+		r.Patch("/trips/{tripId}/owner-email", wrapper.PatchTripsTripIDOwnerEmail)
+		r.Get("/trips/{tripId}/owner-email/verify", wrapper.GetTripsTripIDOwnerEmailVerify)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Get("/trips/{tripId}/participants/stats", wrapper.GetTripsTripIDParticipantsStats)
+		r.Delete("/trips/{tripId}/participants/{participantId}", wrapper.DeleteTripsTripIDParticipantsParticipantID)
+		r.Post("/trips/{tripId}/participants/{participantId}/resend-invite", wrapper.PostTripsTripIDParticipantsParticipantIDResendInvite)
+		r.Post("/trips/{tripId}/publish", wrapper.PostTripsTripIDPublish)
+		r.Get("/trips/{tripId}/schedule/validate", wrapper.GetTripsTripIDScheduleValidate)
+		r.Get("/trips/{tripId}/summary", wrapper.GetTripsTripIDSummary)
+		r.Get("/unsubscribe", wrapper.GetUnsubscribe)
+		r.Get("/version", wrapper.GetVersion)
 	})
 	return r
 }
@@ -922,30 +5098,169 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Raz27bOBN/FYLfd5TjdDcnAXtom6LwItgGRRd7KIqAlsY2E4lUyZFTw/DT7GFPe9wn",
-	"yIstSMo2Jcs2rcSbOr20tiJy/vxmfjNDek4TmRdSgEBN4znVyQRyZj++VcAQXifIpxxnH+FrCRrNH1ia",
-	"cuRSsOxayQIUctA0HrFMQ0QL79GcyiQplb5hdt1Iqtx8oilD6CHPgUYUZwXQmGpUXIxpRL/1xrIH31Cx",
-	"HrKx3WTKMm6W0Jgq+FpyBSldLCKKHDMwL3TeYxGtv8WfPW2Xm39ZKSiHt5AgXUQbftGFFBoOdAyrlg/S",
-	"mmfKkqcbTmmq6a3drt8VF3fdMHu8WyNaqqxul+KdsY7MZhtYOS2dpH1e6IRQxsVdF3Sqddt1+qR40Q2Z",
-	"FDRywczb5mvOxRWIMU5ofNHZuTkXv1xYIyBnPNM3KG+4mHK0/uIIua75wL616YTVA6YUm4WLT/kUIren",
-	"1UGkx2ILeS9A3ThR+w0KNmCtuxMgWP7Y5NHIFB7HDY1Y9QPKl7sGoiUsapbW/bov6DslIipedEnEal2b",
-	"Tu+UkmqvGinoRPHCpRt9w1KiqrRtqpiD1mzcgntTp+WLbUq9BzR0pR/BV7qWs/9XMKIx/V9/XeL7VX3v",
-	"N4W9tmnbTOM2btNByrv9DrOAh4C8tewHVp2mSU7GnmLyHtAEcFXzOejHVf2lvYFAtYv+UCKoMNg8sQdZ",
-	"NxBiKeIoSB7aHe4AfxeqazEHWe85+PlQ9iDYQDmijuDDfNekfmapPCw0LgFNEXgEgQc6oCHIPPowvG2l",
-	"9gP0XW5ztG7r4M5lEYXmCNc3iRQjrnJIvbgfSpkBE7RDu9CaKyGdQE2VHd6/Zgp5wgsmsGvIFN4WhyZR",
-	"m/gwnqxJPdDALkQR2oyuoqVDdCz7UVFmGRsa7kRVQlBMVA3eUqe98A9sf+g5p9uUc7QWvWHj9pb19yL9",
-	"nue0481I39PksQmM2YOLkaxc7PXm73QBCR/xhD389fAPaJIy8vp6QAqmGJFkyJK7HojUPGZF5l77U5Ii",
-	"Y0KcgSKJFBpV+fB3ykhaKiYQiCS/Xf1BfpWlEjAzKz/K5A5QA8OzVXMR0+UeNKJTUNrp8+rs/OzcdjgF",
-	"CFZwGtOf7aOIFgwn1k19n236c+/bIF30q0xzXIjJxB6mFaCsx8w0RK/NY5+JvM+Dy7fVeiNQsRwQlKbx",
-	"5znlRj+jxDLBY1oTTX2cHFU4fg0ZwL6YxY4PrY0/nV+Y/xIpEITLosL631jRv9UuP9b7gyhzEx2GrEwA",
-	"1EnLBkAd+EsYsTJDsiozi4henJ8fJHRXSXGDYotgfxq0mVPmOVMzGtPK85ow4jmWSEEYMX2LDR6bKs2C",
-	"Y/bpm1dcCZSOdxqoS21LkK5wAo1vZDp7MoM3j6gaqWuB2ID51VEUWGJ6GrhbxQkjAu4t0B7ODlQP4P7c",
-	"nU4sjCJjaAG6ajW0+WdwGZTH1YHH0ybw0/l0yyxxGui+B6zyl6TOgLMWfCNalG1JWz4blk/PEJvNURBD",
-	"/HiFwDmqhfW3s0G/fnRQEUNd4KcJ10TJEoHc8ywjCrBUgrAsIzgBYmRqMgS8BxD2iQ3aVYdFmEhJ1WO5",
-	"lyMCU/uq1GZLnMgSyVoRo/kualqfWbwgkmo56Ts5nqpDuAw+/8DH8NXuLuNZIT5Wd9O80n6WDmfj/vjE",
-	"uhw/xGZbA6yF4rzJJqDxOWSOOQq1/LADzApjkRJthmfo5YxnxN4CWlV0YFFz94YhQ43DfFC9f9pcs/U0",
-	"7Ah08xLCzvmLaJmDFEBQrpqXkIl5HW2re9AAdrFXli+kbanfHZ9ct2Jh85Gu7ppDe5T/HspjtSf+L7ee",
-	"pTWp/WjqFNsSEzptodTCFs2LpgDS8M9cX9DI03prd3I04uO5q24sFv8GAAD//6I5Fyf6KgAA",
+	"H4sIAAAAAAAC/+x9247bxrbgrxQ0A5wZDPtix85ODASDjp3j0xtO3HC3sx8OAqFELkm1m6xiqopqaxv9",
+	"NfNwnuZxviA/NqgbWSSLFEm17LatPMRqiazrul8/zmKW5YwClWL24uNMxGvIsP54EUuyIXL7DuKCc6Ax",
+	"qG9xkhBJGMXpFWc5cElAzF4scSogmiUgYk5y9fvsxewd5IClQHINCNvBEJb6b4EzQCmLcYokySBChOrv",
+	"JSe5/gb9i1GIUEElSatfgCbiFP0GkAiEzVd3RK7Rgsk1SrAEcTqLZrm3so+zJYc/C6DxVv0BtMhmL/5z",
+	"lmCSbmfR7A7gNt3O/ohmcpvD7MVMSE7oanZvfkrwVo9R39jNGpD6BWFk3q+2x+2eGY3QOSICXRc0wVu1",
+	"KiIh04Nl+APJ1DK+j2YZoebzebkCQiWsgM/uy28w51gt9sPJip3AB8nxicQrPdYGp0Tte/ZixjI1Qy63",
+	"UYY//PS3KCEbiDJCfzrXX3w/u7cjsNxc4MkGpwXMXkhewP19NFPnRDgk6nyqQ6uOhi3+CbFUw1wIQVb0",
+	"5Rri25QIeSkhe6eeF3IkiPwGRK6BoxxzSWKSYyrnJEGUccTuKHBUUKznMlCkNti+YP2k+mDXuWAsBUxn",
+	"fduNZvUpNaAwnmE5ezErCpLMmhAx/Pj167sOu3WmP2MZr4eeYv0AuHlLfy6h7L9zWM5ezP7bWYXgZxa7",
+	"z/y51OWp+TP84dK8+/xcw6X968lIMHRQpEHviQa95+caGGf3TSgrF/7HjgPRixx3KAuWbMOo+/frt78h",
+	"9TNiS0OMisWJXcppH9TYn+xK/ykYPX2H734FIfAK9BmCXLPEpzNXb69vZtHs6v1NkMbkWK49yB0IauUR",
+	"tg7ULsAO3HOqImdUwGg4M6+NBjTzmoO0Gji1QcLNsXP1BwKKBkQgTMUdKIBGZIkw3e4HIkJiWQjvzkty",
+	"3zgI+2DoFF6uMV3BW0X2fskwSadRDVCv1uie+SaaCI2Reb0Fk+br7n1cVYT4C9+NZYevOSvykYzwxnI3",
+	"oWgSo4AM2wOINDw6NgiJ+lGgNd4AojuYZ5tTljg7CHlr/L2NuNGnZbxDpBazrd7LmUA0EkYhsMv7aDZk",
+	"+WrHTBBzzx9DQh6RKbSZQGtvycw9640YmdUFt8zokvDMQ69pmJWnhZgzav6oQ+1LluWYEmbFM+9m0UJt",
+	"QiCcMrqKkBKOEJFIMnQLkOunaZEtgKMV2QBFzIj/hG6IhNNZr2A8QhBW0q/B4fbxcMASKiVnytEkBcfq",
+	"0XlGaCFDJ/Qf7A6pI6grQSkWUkQIo5wRKrXuo1Seu7U6iYxICckpupQoK4RUOg9aGLakPlqZRXKS63Ny",
+	"ysSTZ8/OvWN7suexGclNDaohMcWSyCKB9g7/sQYO9e2tcZ4DFRESIA0EGC1NfdKjqIWXWJOwYpGCv5Uf",
+	"/Y2c/FhBgAGZwTR8rmb96Y2bNarvUA2s9vij3aF7bL8tYjlkh09+qG3xyQ/77hHL4Baf/GD2+OQHs0kW",
+	"xwUXcyxrdEsNeaIgcDK3tNTKNxT0cZaAaUG/nxGazBewZDxwC78aHEPmd1RuRREVOFFsGWFkxgAeIcrK",
+	"P+qI5d/D86fPvz9/eLQxw9pD0ZvySGOASlykwq1Wg1lsSDckPkkVak8SMZoaYlDy+FHct4PbTFc5KpBy",
+	"g/8xgNpO0j8c9l0mYRne/R4hxvURLQkX0kCKhjJFPBWMqL8JXSlZqoaoXQy8GmFOksD9/bIBvu2Zp1qY",
+	"I/WMJ+bySkls5yJCWnjHJVeYOF85YbS+4us15lDylWrlomvpu89phJzmXWQ3sEwyL3VYhtqwom2H+ncn",
+	"qjgDU4RiTP9NooXCw2xBlNCtaXtdcB2NeW25t70q7xl0t2bNpQ2+hAexUnnEIsMf3gBdKWPF0+fPJ+tU",
+	"ijY+ff68TUR2EY4GLEyiHmrjl0ME9oBS0QuoWmu9LvKcgxCE0UemwEYzyuQEkl+3Jz9X/GwX1cGC0V28",
+	"v31a+q2wnl0O2nP6H5QgBtMO3RGiToR0D1i2LBRZUNKeQJJ9amzEGSuobK/z0uhOGVGaf0GJdDqCJerb",
+	"CMXqCtCScfTzuzcRKnIlNGG0IGlKGNUvCSSY54JhEqfCUkK2Ab5M2V1tw4TK75/VZNrz6r+9JKqABdkf",
+	"W4OK21rgNK7fomdPn/wNxSyBqHI2qc2D5tNgIKapSU1EMyKYmk6vqraSA1BNxUS2wAezjxyTpHZpk6C0",
+	"XIgB0iam+ssogdS7IW/RA7B4Emm3NzqFulevdi/uDaG30+jL/tJ2NCt4nS0UnOxxhTzt4r5mpl2nMOl+",
+	"UkJvp1yOfa97TTec5BNNN1jC3BDakGEL00SfnXYqI47pCgz99BUyRUQ3TIL29hKK8hQb2V9IzKXWTTFN",
+	"tNN6juUpKuVOiW9BIEVz1fDKWxxDpdMhASnEUjj1ZJCxVp3EKyzhrd7CJc0LuY8HmdCfnlrKa313/bwp",
+	"ASEJxSXlI9RRvmfT6R6hPz0zdJXjZYDxvVJf6xMVSNyS3DtCq0HrBTnrQBlLsEWYA8qLRUrEGsZL8lo2",
+	"EXPJ5sZeWbOo7xDf7qe6U7U7v5LpLFAFbAkUvfv3l999992PWtMUEme5VoixgeWU3AJ6ev70+cn5306e",
+	"nCMOOEFYoIwklKzWEr2/eVm3Xu1tHdI2KlbIOU7Tn8ylVaAqdoGWOeN5Zn1o7Q2jHLhQLyr7CCCxZnfU",
+	"xZOYly0ECGv7caD5/Pw8OrRQrMFxfkDh3kxAcbYvn+GQp9u5ZF32T/U7AU30qngcc65oxSIP+cy3xuqW",
+	"wlIiVshTZJ2gQiOfkCRNkQAq0ZKzTL/8d1ZwqpAzSTgIUQPCacdV3ZY9rx0KjNjk8wRwkhIaALQrn/TH",
+	"mDoiUxEWxQas1X4BDgSXhSw4aE5gTZclezhFnx5bO/ZerukboChq6n+x0BUrDn158dtFFYLmawmR2epF",
+	"BpzE+Owas/kVLlJmOLsAvgGOEljiIpUN6K8Tnu+f7Ud3vn82KpjLZ84B7lWjIXWKtUvymiatq6HnCaR4",
+	"CwFF5ldFOxJIiVbWiEBLTFJliuQamCi7i3wjeY3FE4H+LKCARKPbiinZqpBGviIScYiVLitGc/w1S0k4",
+	"FvBKSRExcg84cPGOHMVKI+JblBTaolpBk5InCTVooR5Upv1RAt9/mFlHWoeFNb5AYm455JAwJBiED/7O",
+	"SSsq4w1SNjmf9BMap0UCifa/OD6gSP0CDLU33COrbXMfU7da2OUwN/wd5lSZU9vb/Y1RtEhZfKvuhwhR",
+	"aGG/oNbwW92YKOK1Im1WbN8AT3Geq7cwZToYwxzUsu6heYCdNlU2s+0Qfr4CnLwBKW1w0FiLmNR0RoRj",
+	"FWKN+8lwB+LwIIlbQpPgESln+Rw4Zzz4sw3T6rSJ2N+RXGPpiIO6HAOckaHTUks4d7jUucawTnUXwekv",
+	"FkbAYdybUaMUZT5DkWp9noj6cPEwycwebFRdbO1Ea1cahqakyJ3jjoCYHDqYsQ3UIcFZEHcEwrlXQ6tr",
+	"GpNHrmoKOJeifBvUrOCKUnYHPMZCkUF18bewVdyHCJSp+EXtdzsNjd1rqP8MVved0NExbOt0fmYFja3o",
+	"j5Ok8jyq952DXlZCFIdlobiP1Agck5w4RFoxphV2F2C70COrlbIsTzHRtscM0wKnwYjbXxwhGRGc9zNO",
+	"HBlphdQpK3NIJxUSL1JAJAEqyZIAd0xBSTMFB4v6lMm54TRaFtAS35wo640RD+KUgLU0LTim8RoxGgSd",
+	"BGQnXBY0AZ5uFZvSWB+ZIAITeaU53P9OYFGsflIgpQQmQRIt+OacJUUsSWjOfqj0tPV+E58+v+r5IJAZ",
+	"E+11kWWYb3/GKR6Si9II/K3eqp9PjkmCUoW1Yo3VtZjQug1UMKkZu0DsDppRbkGHSJtrqjkGUb7xwZFK",
+	"nFPrnkJYG3PZdboBo/LMdt/JFd4CH3kjE3aqnVIPslMzUnhjEmhyqTWj6d4IwqE3yooWaarogwsa3+mj",
+	"cAOGlvwaZMWf90gjsXKMCJszuy7loHkjAV+FW+TQk5h0hWqW4YHS3dMqJW1nyoOZbNx+9MCTfN0ERm1M",
+	"TdSW/t4WEviFiwNvxoV7AvE4f497MfIX23EwDTVH7GOMGH4ijVl33q0dvmsPRZ6SuAqMmy5f6zCvURfb",
+	"M7dJYNi1NTvl+K0Ny4/oDNQI6O9v0wSENOF+kR/5R0FJ3beQSyVwYpRobWaPuLsJMbTDQ/ydH7aaIapv",
+	"vOOsX6dsgdNriaXYL7LS/jVATikjVOclqRwn3Qx9ZczwBZ2yrCA1bu8wNHyNTjW213FXe3rRByC2mqGE",
+	"g4sg6uqRehYo9ljhKCIUWusOsmPmGLJ4M964HQyVRDsQeli0Rm9qT1cQxmuQngNqb4YxQRpoTx/k/hQ+",
+	"yLkiYCwQevvGOkScLVmZolCOV3C685R2iwTeAq919uTUs9lgifncXmV/mNVrbp6OEDbWPq02mu+MvUMW",
+	"XOftLZd12x4nI3XqgbBJxLykU+G0NWmFxx3I2XmcSiZ8u/hnGI5r09u5xt6Xm2DctTViUFoH44VMPKjl",
+	"us8D3/SsDpk4dKp1F17NX1eNX22x48BvWIK3D0w6GnkFjEtr3dNlPbTPUiE7RksOumzGYA9Xp/ZxSWmP",
+	"9mH0yRbeqq2Hq4y0fNNhil/5jPvvy43gXhiizIT3+UgVvNF0eectPnTW5YVHimtpe0Q0ky5Pg5LkQMzv",
+	"zoq0KwglPwaWtcZCuYRUPRxnbG0nD9bzA/szFgPTY/mws09Qg8pX5uZ5GXL/lw85ZLWWd0j0ClMTSROq",
+	"FaSN2jmH6u4ZNU69coixZuycUNrFRR9dktW0VHJf2bTbHYXGHqV4ODZypReCqkc83Z72JNF9OnaytmFc",
+	"ZcAH3vpYpZasTxYxs94AvGJFjN7fvCzp0DAsUj/2IZD6vQt3DoAkYe43iils9zFdAOYpUQEA083tmpDv",
+	"O0QOfJ4YJJgAjvUjeNVhV53q/zAvRrWz8jddrX7sZb0az70tPg20nE3dspPCOh09dldlZuHehtc2gbAh",
+	"UQoaavQLcFzLJnXYqumZJSiEm1dsSqp0XNurBKMHHkz+GuVp9rPu1rMNpqIuEfOcpSq2L8xhvfSMCTkQ",
+	"O7foTV/N1bdh7WIXe7iUBtKExkRd6nafat0zzF5KdV9ixxQV277Qx8/sI5+QpT2gqYWIeZm8EvzVQ4Ce",
+	"aPMqZH7DpKlcYKUOCSIK5xvplwoqQHpVNOsJRpgicwCBgNjd8fD/UEftR+EKFKdMQN0UVko+Vs1w4w0X",
+	"eERarIIhNmvGpR9howJmdPAC0kbiCOE4hlyByt0aOGyAV6u5fIWICEbSjLbXeK/0wXF1SZ8SkqfGugeP",
+	"ZgPcRdk18uXWJkOOUZtjW+TqwCIkgCpGhRY4vjU70r/4cdDNIJrvnu7m7gGbWMgM1rJGlugY1am/BrFq",
+	"f96x9dDXegzMRLZgg2xCTk0KKAceLgZjUw4UsAuEk8SmdOszVSJP1EgJFyb+NWEqnzshG5KAuikdBsY0",
+	"lGmhxA5hJA9vuuFiRjhYKyDMdmdw/0qEUABytyYptOiHTdgVo1V5vAU+XJYIBTgdQiS3y4oqQNgNcWK/",
+	"NOlxApWdc3ekgxu8Z/0Ng//UfdR47gC/8BpwEodrJ7wMIZdAquadTTLxK9xtTUGWgWF/Jr1mUsyze9Vz",
+	"Q8/8fQw746nn+2AevLbHf4xqHNrJMJP0EEd8/wxfhcNwaNbpfeSHGE62gJBw/sYgIdnM35HCYZN4ibAy",
+	"cA40MTk3ic7D1MZrugJuajbZucIyrXPT7dxNrezlEHHEuuPcETckD++Aaxv2J+qB1P2EDC0Oz0sSOJYa",
+	"+a/3rFF8qmDLPh15cqylCfndu1rqATO9Hy4RPkKECgk4aaT3wWdIkN+/vOye5WJtkdgRNu7u3NgAEE1C",
+	"iSpFsz/TiIj+pMzaraM7ZuvaKb4TIo+NfXqrCG32DRGymQAk9tzwKHtfc/Kd2F+bpWtL+5CyB5ScHgdV",
+	"/BXz21rxvVeMTqy11lVGu7GWznrWofCzaUHED+Qp3BVv9ABh8HUTR7n+4PEQul8x625fd1O67vYSvwOc",
+	"EApiKv6EcyvrFQGE1JalBFYcJ5B4ZgKbTBgTHhcm35LlQHUl7YSBUMQPL5cQS8TdOk/Hl8mot2/ouMKe",
+	"7g3XKgO0SOFSiAIeNAxecQZ5x3wfNKEblm5084oHqjzb6xJXXnDXVAViRpNG6dtpsVejvQrDyrHb9H1X",
+	"LHiFc1OYPRwSNMEsbL6omsHYCWfRbIXzQHZqkwyoX6OGR91cfZe50208BHc3IL7sBhveBqaZv1zqbxtu",
+	"1agu99imyG6AL7AkWeQnJ9NE5++ON8MLoHIAadWPBfded22O3PiBAl8noMSGyeEadRjGzRC7D8nUwJt8",
+	"Uo++BpI+sy+rbFMb24cGL/uW6L1K/O4TXlcV4B2SITWhtoTvE2n92CguOxVp/QqyIwthJ7P6KnZUe91Z",
+	"PcIvYfQAQUSh3XZE5YeDhfTDoYW+197Mb70MrDmFPUquHrRG6BdHuh+wrmWtwZKpl6OfUu99BpPevpUk",
+	"vc0UAtqFJfEKE/pNlId8G7zWMoQGvaUxoBwLocMBvcYVOh4jQZhuM8bhG6wQqVGsM15Fyfz2RxNVqUmb",
+	"shgssNBN9nR4tl5nWZ9Uw6mpsyOCQSsPVvM+WGR9Z6yL222Idv9up3LGh6nRrjjvzrpRh0qSFIx2b1Vq",
+	"ge6AK4CE+BYSg7pCdmTd6Mp7Q5PKlhq+YqVHIWG3NThMpW6E2RkM6227XGbwmM0VqKiClMRTrf/dxZNq",
+	"ID02aMoNuwNSzG9Tw5kKkiZzJ561BVyWZUTu2li/sOYeLEeL/FmDW2ISKr1wonV0ZPeey1cV4bDMWrlR",
+	"D96NobHO9nEY0WMZYM6/iBxisiQx/uu//vp/IFCC0cXVpdoWRkyH8J0ATdTXWBf3+Ou//vo/DOUppvTU",
+	"BKoJyYu//m+CUVJwTCUghn5784+ysnOC0TsW34IUgA0NMILxzI3hweaL2ZPT89NzEwcOFOdk9mL2nf7K",
+	"tDfWB3NW2TrPFqpAjr4rZq5X3Z8mmapA6OyKiWY1nVlZPvJn2xg4ZlRaUw3O9SbV+2f/tFXtDPmYUg9I",
+	"z9K8rlJe8voqPz0/P+hCzFRmJY1y+rZycfVMNHv2gKsxpfcCE/v19e51gVod7DB7MXsNspVVtUQCNsBx",
+	"aiv/Y1PfV4OTRpd6Sqoa8AwnGaFnphjPWQI4OUlBSlOzbAUBYFEnp94xtYWqqj+zw95WZ1GjL+O6lPu0",
+	"VupUB53ChzUudJyzyWvhIDkBUbswddahu5KWUjuUDmTh6QhjVXRzpSRel0pTyAX7YFLsdJYTmLgHHXHF",
+	"uBahkYEB8Kr8WtuvYAhbQ6+OjVWql1AhtjhzzgNvAFEGIi1sUHdhDMQB+lPB1I2pJnkI+tOy9H9istM2",
+	"1H8Z4HutQAn75XiRXHNWrNZVwfFVoQDG8xcMAuOP+t/L5P7MwscONlWBif7/5at39jUdUYkzkDqW+T8/",
+	"zogpYinXzn5lleHLZNa88sg7uV2Wvz9a4PFs1M04l5cKs1NCSD3c7tGCg5rz2eHn/I1JU188DIAebWmZ",
+	"PLpBTUgsxU6GpuOuD8zFQvXIBl15kPfbVnQ45kwIm15SZqh0n0YjoKj/UPyHD3g2/RFT44+o5Le4rNdf",
+	"ld0nwlXcD51U1MFSLxIbWuvGRDjlgJNtGbWk5uSge12ZHlamWLRmgs7g2EHSWuf88LyvvyXnIEb45OEI",
+	"QCtA7cvgg5LlWq5SkFCKcswDiuG4Z1nfvYG0FIxhoA4fr/T3LQj5xRp1B3K8Xn535G+Phr/5AIWqENOK",
+	"3uxmdWVspKXqDa3AqIXcxjoZy6Ep1mGSEguq/k2QTgUUOujnFF1hYcKMvRhOU6clxyvFhdDKMiSlEeCl",
+	"1Kn9bWLneMqNrRAZgt4/C+DbCnxTYsxY1an7TVV77cr391F4TLOBWR8ORIF46wwjAWrJWl3jJEdLAmki",
+	"jAdCFpzawvUkiTybdOT5LG6qkuk6FddrgRRaqBl/Ng5ZH5Yl1yN+vyBVuxKGIgOli20VUBzAnpaFrGFn",
+	"LxYndjaBeKGdjRqFNG8nNIEcaAJUptuoriC78EqhOIfJbVY49Vbll169vb5BBmUjdPXefT77aHrY3Ef+",
+	"E+W3nmGv4wGdlaJXdvW+49ezj6aD6L2GRJyqxhwdmvkh7YGf0wT4JVr93hW0ZuOLqlpl6rrVnaI7TqSO",
+	"b9XwZ8fx4d7AuoF7Px/wrF53KsxBWibHCtM8+1aVaxahDPjKth0TNXeVxQLjZYxZZqo3gEAF1W0nbMes",
+	"eY6FNDJ7mKn47uWLRtXhXQxmt3zU36o6wCuuwfTy4oXmjWmpiVTHpo1/TntY4zwHqpgKY12swD+KEEPw",
+	"oiYPw0gTyDnEWFYn1MQV93ukIxMMkz1FL0u/O8sWhDqLIulkemy5FNBYaXcGU+D4b9qCijr9nMOGsEKU",
+	"XGCiaPDHYQ0E/YWMvzBbd51QuEABXWiy2mYjLdXSqHqecptUffT+UkzNDmL8kZaVN1iZ+tonFd7ny1c2",
+	"232QTlWben9b4gE0fbOZQMbmveWxR/3u8PpdNHv25BPs8tLGu5msaeXBeXf9+1UZCWVjoBooaiGkiYq6",
+	"GnHTgjgWFct0iBIR++MBdC9SXQVHMpfAHhk2oUsjizUi3h63p6gqTWE7nIOte2qfs9FzFO5K1dl0SMV3",
+	"eBv5natcEZ47r2BTUA7uJR7DzTGPn3To8Dhvd+O9ZUdycihycv7j4We8ZhkovQFSAc6wrYX7SlxV8isR",
+	"VtJvERbOIZYNuuJcNTQxGOfhasumNZrc6LZk/V7Dbsw1L38G1D2QCBls0nbEorDR1RyWMt573FN/1N2O",
+	"LYA6oCVyf2CtkmS7vG6doGr6QHxFoNrbluQIsmGQfQ1N2lpr9q7gq1EG3oZlG4mKFeULtlpXDyRrev+v",
+	"PmB9Z544IJC00+YHQsbz8+8+7SKugW9IrMsQbzAxMkzDeAc541rWNP3Q16CDSImJpt8qsVUHziDJ8XJJ",
+	"Yv961oBT6Yx2TSdP6146PCwDfBqXr0SEsEQZExI9Pz9F7+ktVdk1snQdpWWxjqUzaOtD6bRdJWKUA/Lo",
+	"fKkKbYgvNCrVEp1BIam2xIoXd9EW4BxAHy4uwk/e+8SxEP4CvqjbNgtHWGva6hoDt1rSq9IBtZNwqf9d",
+	"vtpFvm68esWM63AbVau2xOe6SGTm3o8OXSSJQOsiw1QTbN3FPMHW16JVorJa8QY4JzpI5EIXWj55g+mq",
+	"6LE8mzdnHY6H755HRzI5vLjTUPT5zkhybckrYwlZEkiMrch0mkcxy7coU4Yg237/lxu8+oaF0GbSX4Ce",
+	"FyFyXjxuLLfuO53qpD6IW+vZ1Msw6ZBJGQTNwkWohcUzbVdckg86cBDJbd7p4aNMkuW237d3IJtfO3v9",
+	"aOf7Wux8XWmXgTWUqOZSpAWhsc1WJRugLi24QQwM9AT8Bt0SwLBAA6UXsUICulOVbQ37UpEqVXsHtAB5",
+	"B34nhXC7B9sLAjb6USag1IWrhQQjCzxC1RdY8LlJVhlGUW0H3el96ixCRaIkJtTabSV8kBEiK8rUeCjG",
+	"wlgMcByDq2cfIFB/dgknT9p1Ex698PQJZJLp7vujWLK3WFLH62Dy405189Hh/R+H1H+bFTI/iw5cLeJo",
+	"Bv5M/r8SionwRFsd5SqFz2C0dqhS1pMuq4CPidveJORO8eB0BcxtLCgmXDciCi88DljauxlPCMXSLdpT",
+	"aYdx/Nd2EY+CAAzmWitg/6sNFIGaB83iSq+B/f367W/o3wHLgsNLlqYQq19dUMaVbhO8ND/rTjxVLdMY",
+	"c77V7jMplJnBiiAKgKp+qt8qd2oHxTksEQiX5+4ONi4PfhrynCWQFPmOhNogwL8yL35Z8D7u/swWv6QY",
+	"yyPL6WQ5Jk0OJYVZW60/coRuAXLXVs31eUW2AKPCQd3fS3f1nIpnbl4x0LbtYVr16teMbaqAh9vpEedG",
+	"8wwNnIZfVNgg1pg7sBY4A4/Tlu37x8Pyzlz5IBi73PmvGoK7+3If4bdHI1+tOKyw1NUbJRGSxDXJZ7em",
+	"3g+wktkiuaMA9ka/9bUDrNrkkdzuKaJrAOvu0TABZD/az9vLZED2fxB+L9wIj8KDFhio2uJeAYgtO7Jm",
+	"hUgDhbV3GneFcsWZVEQW25LbYMgMB/W39sCVWqr3zP8w8Soa/P+n6TMsmef1sNccsjuLmOV1s3PZz6Ic",
+	"fxaZJYeaWhxLLRwVmaAig6kPq3wnYD8MKTrLCe3J27nSzY1a2yC6fp3pi1wVgfOfkr6ImkFHds0OMndF",
+	"6JHSfdmU7uFdF4HOXsfQhSOZ3Ulmr3T5DlTQnFCf2I4horHr/TdQ9yh7BX4LanK52aPOMVDnsJE39tiM",
+	"xcc4trAQZEWhxuHLB3vq1amelAYHcJIoBDETAS1726oBwjVfHi/cHsr/XWvl+Vmd4I2VHPGnF38uEp3A",
+	"RyRkta4pJYZ0oU0fST/7qMYbq53XLu7RKuZmZ8cSuF8cpDvd0OMS6i73gu8zx1x2FU7pBvMLN8LXDe4P",
+	"z3fMwU3nO0ec+wTcRd9RC+dMhc5a5RReNeOKjG5hUMuWupqOoa45+DTsVE3Jj5g5Ekx6W7sfcfOR4Ka6",
+	"pTZmKnRRUEyZ1J/HIF9VQ2yIPt9VMeyza/NH4Ds88NnbL73YrkiIqzBPN0TqhYiBKTG6r5/pLzg0/KJq",
+	"3PVNhF542z1qxWOsSjo/y4JW2dCHcKTbhU8Az7OP5oP6XkAKcU8fol9oYnwXOUtTv3KbnyxpvBdxraqb",
+	"NlxJliZlKbcE2+X2mqs8KDH/XL66Nmt8nFKQO8qjQn70cAQ9HEqQUcij3HomYc62rm11EEhN4TUP3REW",
+	"dTKwL7orktGD7CqQ1teKVK04JY+p1yL1f7UJXUQLqZa9wjbwdRhu5pmC4qqP5VeP4A+v6ITbfx41nCOB",
+	"MQTmMZewVbBrCtb6BM+rM72julmD4sFGrbUzz0yTNv2MPjKsPc6204lACl2rUvynNrfA+8aVjWBVqtSp",
+	"8Sskke2RyLl2WSOdApTjbcqw135RLXNOkrK0WzkKMvn3wsRzqGFMKrgr/LLG0iX025oUuY7jOUUXqiZ7",
+	"pnakD9k1xcqBE5aQGKcmPkRlT7gmf9TmgbEc6K70uV/MgT5+xUTCB2mu/0RIDjgLJsyVAx5JVbB/kT45",
+	"L0rJdjFy2W2mI+SJhjCDagMlEfiQg7u7ATrxL+7xb0Ahdns9asMDtWEHS1X6QYRYmoCQJn7RB0n37PBK",
+	"AY8L9A7WP9Ds8rNGSJRrOML955Yf/argJXrpoDpdRA7Z4NBts0UIWyGsM9ggQTETsktw89CwhzmclSOP",
+	"YhLX9q1vh1fYHR8x5/NjThNjlMalxHXTtqsDbxQnu9Od45umlhxb7YDdgYiQyFMiZZn17OaBPwst1/uF",
+	"s8IF7CYg4Uf7aWzclENH+++jjZwqt3e01X6xwVPU4cJw+DZ9AMTgMhqX9vmvVAw02wv3lvqUomBgHUem",
+	"1osD1swmbIcXL1p2nKlMNxAdKGq90c9+5QKW3uQR/IaX59MQ5AOd/mK4qv2IoOpQerba4mdVss0CjjDd",
+	"HwZUq3WnoDgE1V00tGzCPIaYqv89WhHZ7Ocxtxw6gvVgUi0IXaXQD9qDirx/O3B7qOLso7nBUac8PJbU",
+	"Kp+PIf46S+BkSJ9Uv4XpCqTyD2+Ak6Xdod9YUft/OeQpjl25AG1DktqexGgM9lVXP1qvWnl360+76Qoq",
+	"ia61Tk1TkObvRJjwPLxghfQcf7vLO7xV2+/omfqViHD6JKp9jkLdp4dF3d8D8PNnAQUk36YEZ2tqOEjT",
+	"uGnPZaCL2kPnM41iQ/0QFYD8bl57dJ0F9IzsFqiJDWk3Vu4qGKJfOuYxfMN9ySvQIYqZ6C6CkR/j5VyH",
+	"RSC2S6OD11ishpaa3WVYtbGSVdadD5EDkLZm5uqK+novAP1p+jJiXne4CFWaQQE7Ym5dO5pq9FECv+vr",
+	"oxGRhzYAefr8eTR0kJRkRDYHIlmR2T4iGaH2r3JIQiWsgDvylHPQJX7dPppI7X6PFGApqUUwfopeYvpv",
+	"Ei2UtytbEAo2tI50NjZjy6WAxkrd2s671tYW3z7IuVmCc3DnHDaEFQLlPQ1NzCufu6uaD5RHlXm4dbPL",
+	"jbrbsu4/MaoSr39V30wt3kb36COMDoHRNbtDGaZblAPLU9BpVsbDacIHYpbpqAEW6Og1EoAbzc9HxQR0",
+	"tkF/pAakQ7Zc/2bk4O8+YfiNkRljTHX+wwIQB5WSlHzloUetkCE/JtYpwa2+5epkGgUvlpxlD04izjgI",
+	"oMmJIUiDIy46icU7PZzxex8Jx1GBfjB0ffqJ0NUgArrDwhlgGOIQA5XptpX/aVOD7DtWV9ah7jlQ3U/R",
+	"R2CdhjkSdYtFSsR6OF7a548lMr5FadPevkrQ43gpG4UyKruNjT/1DdIDbThqaUmRwtkGp0T5YjoNOW83",
+	"wFOcC0ShXsLadjRLCgPFp+i1ekoJxRlgUShTlYuVjdVNxoUkm1aHAFfeOsHbqKNTgEq3SwFT5BatA4Ap",
+	"Q0SIYnf72Wv71u9up4/OVHyZpIAyQotaY9471mp4propcMhN4z7d+GyF8wg9+eFcmdRsfeouy8gK53M7",
+	"SYcV6dmzXWakQ6qm7n7cfR110p3+F4hvneWkxI2lMpgZnM29euY6Q5YmiChYW+F8VM+YcTki31BuyDEp",
+	"ZISJj2q43BC4M9Ssr+t5QUWxUCMvoKfPuXOTKBgxrngOMcl1c2eX7h1jCSvGtzaFm0NGaAJcnKIbjqnA",
+	"Oi0bp5Z9CvuYjVy3dh2Py+rYBNMYD62YQrCufqjvvS20UOFQjr9ghvZaZunIzOybKrvF7FvZ3MOgu88U",
+	"6jQREeq4FfVvZUYzkz/P8volGfipblZr1NKNJ9dg0/l3yOiuK38PWfvdPnJIvmemGEdDgkm6dj9OruIF",
+	"pQpAFwVJE/8o1oBTuVaHcH///wcAWqT9Xqw1AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,12 @@
+package spec
+
+// JSONResponse answers with a body shaped at request time, like a trip
+// trimmed to the fields the client asked for, which the generated
+// constructors can't take.
+func JSONResponse(status int, body any) *Response {
+	return &Response{
+		body:        body,
+		Code:        status,
+		contentType: "application/json",
+	}
+}
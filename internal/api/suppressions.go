@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// GetAdminSuppressions List the addresses no e-mail is sent to.
+// (GET /admin/suppressions)
+func (api ApiServer) GetAdminSuppressions(w http.ResponseWriter, r *http.Request) *spec.Response {
+	suppressions, err := api.store.ListEmailSuppressions(r.Context())
+	if err != nil {
+		api.log(r.Context()).Error("failed to list email suppressions", zap.Error(err))
+		return storeFailure(r.Context(), err)
+	}
+
+	response := spec.ListEmailSuppressionsResponse{Suppressions: make([]spec.EmailSuppression, len(suppressions))}
+	for i, suppression := range suppressions {
+		response.Suppressions[i] = emailSuppression(suppression)
+	}
+
+	return spec.GetAdminSuppressionsJSON200Response(response)
+}
+
+// PostAdminSuppressions Stop sending e-mails to an address.
+// (POST /admin/suppressions)
+func (api ApiServer) PostAdminSuppressions(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.CreateEmailSuppressionRequest
+	if err := decodeJSON(r, &body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidJSON, "invalid JSON")
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "invalid input: "+err.Error())
+	}
+
+	if body.Reason == spec.UnknownEmailSuppressionReason {
+		return respondError(http.StatusBadRequest, codeInvalidInput, "reason is required")
+	}
+
+	suppression, err := api.store.SuppressEmail(r.Context(), pgstore.SuppressEmailParams{
+		Email:  pgstore.SuppressionKey(string(body.Email)),
+		Reason: body.Reason.ToValue(),
+		Note:   pgtype.Text{String: body.Note, Valid: body.Note != ""},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to suppress email", zap.Error(err), zap.String("email", redactEmail(string(body.Email))))
+		return storeFailure(r.Context(), err)
+	}
+
+	api.log(r.Context()).Info("email suppressed", zap.String("email", redactEmail(suppression.Email)), zap.String("reason", suppression.Reason))
+
+	return spec.PostAdminSuppressionsJSON201Response(emailSuppression(suppression))
+}
+
+// DeleteAdminSuppressionsEmail Send e-mails to a suppressed address again.
+// (DELETE /admin/suppressions/{email})
+func (api ApiServer) DeleteAdminSuppressionsEmail(w http.ResponseWriter, r *http.Request, email string) *spec.Response {
+	deleted, err := api.store.DeleteEmailSuppression(r.Context(), pgstore.SuppressionKey(email))
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete email suppression", zap.Error(err), zap.String("email", redactEmail(email)))
+		return storeFailure(r.Context(), err)
+	}
+
+	if deleted == 0 {
+		return respondError(http.StatusNotFound, codeNotFound, "address is not suppressed")
+	}
+
+	api.log(r.Context()).Info("email suppression removed", zap.String("email", redactEmail(email)))
+
+	return spec.DeleteAdminSuppressionsEmailJSON204Response(nil)
+}
+
+func emailSuppression(suppression pgstore.EmailSuppression) spec.EmailSuppression {
+	var reason spec.EmailSuppressionReason
+	// The table only holds known reasons, an unknown one is left blank.
+	_ = reason.FromValue(suppression.Reason)
+
+	return spec.EmailSuppression{
+		Email:     suppression.Email,
+		Reason:    reason,
+		Note:      suppression.Note.String,
+		CreatedAt: utc(suppression.CreatedAt),
+	}
+}
+
+// suppressedEmails lists which of emails are on the suppression list, as
+// given. The invite still goes ahead when the lookup fails, it only costs
+// the warning.
+func (api ApiServer) suppressedEmails(ctx context.Context, emails []string) []string {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(emails))
+	for i, email := range emails {
+		keys[i] = pgstore.SuppressionKey(email)
+	}
+
+	listed, err := api.store.GetSuppressedEmails(ctx, keys)
+	if err != nil {
+		api.log(ctx).Warn("failed to check suppressed emails", zap.Error(err))
+		return nil
+	}
+
+	suppressed := make(map[string]bool, len(listed))
+	for _, key := range listed {
+		suppressed[key] = true
+	}
+
+	var found []string
+	for i, email := range emails {
+		if suppressed[keys[i]] {
+			found = append(found, email)
+		}
+	}
+	return found
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+)
+
+// storeFailure answers a failed store call, using 504 when the request or the
+// statement ran out of time so clients can tell a slow database apart from
+// other failures.
+func storeFailure(ctx context.Context, err error) *spec.Response {
+	if isTimeout(err) {
+		return respondErrorCause(ctx, http.StatusGatewayTimeout, codeTimeout, "request timed out, try again", err)
+	}
+	return respondErrorCause(ctx, http.StatusInternalServerError, codeInternal, "something went wrong, try again", err)
+}
+
+func isTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || pgstore.IsStatementTimeout(err)
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// utc is how every timestamp leaves the API, so responses are always RFC3339
+// with a Z offset whatever the time zone of the server or the session.
+func utc(ts pgtype.Timestamptz) time.Time {
+	return ts.Time.UTC()
+}
+
+// utcOrNil is utc for nullable columns.
+func utcOrNil(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := utc(ts)
+	return &t
+}
+
+// naiveUTC is utc for the bookkeeping columns still stored without a time
+// zone, which hold UTC wall clock times.
+func naiveUTC(ts pgtype.Timestamp) time.Time {
+	return ts.Time.UTC()
+}
+
+// naiveUTCOrNil is naiveUTC for nullable columns.
+func naiveUTCOrNil(ts pgtype.Timestamp) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := naiveUTC(ts)
+	return &t
+}
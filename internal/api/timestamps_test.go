@@ -0,0 +1,96 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of the tests")
+
+func TestResponseTimestampsGolden(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The times are read back in the zones a session could hand them over
+	// in, every response must still show them in UTC.
+	store, h := newTestServer(t)
+	trip := addTrip(store, pgstore.Trip{
+		ID:          uuid.MustParse("0190b5a0-0000-7000-8000-000000000001"),
+		Destination: "Lisboa",
+		OwnerEmail:  "ana@example.com",
+		OwnerName:   "Ana",
+		StartsAt:    pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 9, 0, 0, 0, saoPaulo), Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: time.Date(2030, 7, 15, 18, 30, 0, 0, tokyo), Valid: true},
+		Slug:        "lisboa-a1b2c3",
+		Version:     1,
+		Timezone:    "Europe/Lisbon",
+	})
+	store.activities[uuid.MustParse("0190b5a0-0000-7000-8000-000000000002")] = pgstore.Activity{
+		ID:       uuid.MustParse("0190b5a0-0000-7000-8000-000000000002"),
+		TripID:   trip.ID,
+		Title:    "Museu",
+		OccursAt: pgtype.Timestamptz{Time: time.Date(2030, 7, 11, 22, 15, 0, 0, saoPaulo), Valid: true},
+	}
+	store.participants[uuid.MustParse("0190b5a0-0000-7000-8000-000000000003")] = pgstore.Participant{
+		ID:        uuid.MustParse("0190b5a0-0000-7000-8000-000000000003"),
+		TripID:    trip.ID,
+		Email:     "bia@example.com",
+		ExpiresAt: pgtype.Timestamp{Time: time.Date(2030, 7, 10, 12, 0, 0, 0, time.UTC), Valid: true},
+	}
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"trip", "/trips/" + trip.ID.String()},
+		{"activities", "/trips/" + trip.ID.String() + "/activities"},
+		{"participants", "/trips/" + trip.ID.String() + "/participants"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(h, http.MethodGet, tt.path, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("GET %s: %d %s", tt.path, rec.Code, rec.Body)
+			}
+
+			var got bytes.Buffer
+			if err := json.Indent(&got, rec.Body.Bytes(), "", "  "); err != nil {
+				t.Fatal(err)
+			}
+			got.WriteByte('\n')
+
+			golden := filepath.Join("testdata", tt.name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v, run the tests with -update to create it", err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("GET %s answered\n%s\nwant\n%s", tt.path, got.Bytes(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+var (
+	errInvalidTripID = errors.New("api: trip id is neither a uuid nor a slug")
+	errUnknownTrip   = errors.New("api: unknown trip")
+)
+
+// tripID resolves the tripId path parameter, which is either the trip UUID or
+// its slug. Slugs are only looked up when the value isn't a UUID.
+func (api ApiServer) tripID(ctx context.Context, raw string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(raw); err == nil {
+		return id, nil
+	}
+
+	// Slugs get read over the phone, so the case doesn't matter.
+	slug := strings.ToLower(raw)
+	if !pgstore.IsSlug(slug) {
+		return uuid.UUID{}, errInvalidTripID
+	}
+
+	id, err := api.store.GetTripIDBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.UUID{}, fmt.Errorf("%w: %w", errUnknownTrip, err)
+		}
+		return uuid.UUID{}, err
+	}
+	return id, nil
+}
+
+// existingTrip resolves the tripId of a /trips/{tripId}/* route and loads the
+// trip, so an unknown trip isn't mistaken for one with nothing in it.
+func (api ApiServer) existingTrip(ctx context.Context, raw string) (pgstore.Trip, error) {
+	id, err := api.tripID(ctx, raw)
+	if err != nil {
+		return pgstore.Trip{}, err
+	}
+
+	trip, err := api.store.GetTrip(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Trip{}, fmt.Errorf("%w: %w", errUnknownTrip, err)
+		}
+		return pgstore.Trip{}, err
+	}
+	return trip, nil
+}
+
+// existingTripFailure answers a tripID or existingTrip error, a bad value is
+// the client's fault and an unknown trip a 404, while anything else is a
+// store failure.
+func (api ApiServer) existingTripFailure(ctx context.Context, err error) *spec.Response {
+	switch {
+	case errors.Is(err, errInvalidTripID):
+		return respondError(http.StatusBadRequest, codeInvalidID, "uuid invalid")
+	case errors.Is(err, errUnknownTrip):
+		return respondErrorCause(ctx, http.StatusNotFound, codeNotFound, "Trip not found", err)
+	}
+	api.log(ctx).Error("failed to get trip", zap.Error(err))
+	return storeFailure(ctx, err)
+}
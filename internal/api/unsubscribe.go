@@ -0,0 +1,61 @@
+package api
+
+import (
+	"html/template"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// categoryNames name the e-mail categories on the unsubscribe page.
+var categoryNames = map[string]string{
+	unsubscribe.CategoryReminders: "lembretes de atividades",
+}
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="pt-BR">
+<head><meta charset="utf-8"><title>Journey</title></head>
+<body>
+{{if .Category}}<p>Pronto! O endereço <strong>{{.Email}}</strong> não vai mais receber {{.Category}}.</p>
+<p>Convites e confirmações de viagem continuam chegando normalmente.</p>
+{{else}}<p>Este link de cancelamento é inválido.</p>
+{{end}}</body>
+</html>
+`))
+
+type unsubscribePageData struct {
+	Email    string
+	Category string
+}
+
+// GetUnsubscribe Stop the optional e-mails of a category, from the link they carry.
+// (GET /unsubscribe)
+func (api ApiServer) GetUnsubscribe(w http.ResponseWriter, r *http.Request, params spec.GetUnsubscribeParams) *spec.Response {
+	email, category, err := api.unsubscribe.Verify(params.Token)
+	name, known := categoryNames[category]
+	if err != nil || !known {
+		api.renderUnsubscribePage(w, r, http.StatusBadRequest, unsubscribePageData{})
+		return nil
+	}
+
+	if err := api.store.UnsubscribeEmail(r.Context(), pgstore.UnsubscribeEmailParams{Email: email, Category: category}); err != nil {
+		api.log(r.Context()).Error("failed to unsubscribe email", zap.Error(err), zap.String("category", category))
+		return storeFailure(r.Context(), err)
+	}
+
+	api.log(r.Context()).Info("email unsubscribed", zap.String("email", redactEmail(email)), zap.String("category", category))
+
+	api.renderUnsubscribePage(w, r, http.StatusOK, unsubscribePageData{Email: email, Category: name})
+	return nil
+}
+
+func (api ApiServer) renderUnsubscribePage(w http.ResponseWriter, r *http.Request, status int, data unsubscribePageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := unsubscribePage.Execute(w, data); err != nil {
+		api.log(r.Context()).Error("failed to render unsubscribe page", zap.Error(err))
+	}
+}
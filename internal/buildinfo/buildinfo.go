@@ -0,0 +1,34 @@
+// Package buildinfo holds the build metadata injected at link time:
+//
+//	go build -ldflags "-X journey/internal/buildinfo.Version=v1.2.3 \
+//		-X journey/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//		-X journey/internal/buildinfo.Date=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package buildinfo
+
+import "runtime/debug"
+
+var (
+	Version = "dev"
+	Commit  = ""
+	Date    = ""
+)
+
+func init() {
+	if Commit != "" && Date != "" {
+		return
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+
+	for _, setting := range info.Settings {
+		switch {
+		case setting.Key == "vcs.revision" && Commit == "":
+			Commit = setting.Value
+		case setting.Key == "vcs.time" && Date == "":
+			Date = setting.Value
+		}
+	}
+}
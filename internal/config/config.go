@@ -0,0 +1,394 @@
+// Package config reads the settings of every subcommand from the environment,
+// with local development defaults, and checks them as a whole so a bad
+// deployment lists all of its mistakes at once.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/api"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap/zapcore"
+)
+
+// devUnsubscribeKey signs the unsubscribe links of local runs, production
+// must set a key of its own.
+const devUnsubscribeKey = "journey-development-unsubscribe-key"
+
+// Config holds the settings shared by every subcommand. Secrets are redacted
+// when printed or marshalled, so the whole struct can be logged.
+type Config struct {
+	// Env is the deployment, development, staging or production. It
+	// defaults to production, so a deployment that forgets it stays on the
+	// safe side; local runs set JOURNEY_ENV=development.
+	Env      string
+	LogLevel zapcore.Level
+	Server   Server
+	Database Database
+	Mail     Mail
+	Auth     Auth
+	Jobs     Jobs
+	API      API
+}
+
+// Production reports whether this is the production deployment, where
+// responses never carry internal error details.
+func (cfg Config) Production() bool {
+	return cfg.Env == "production"
+}
+
+// Server configures the HTTP listener.
+type Server struct {
+	Addr    string
+	TLSCert string
+	TLSKey  string
+	// RequestTimeout bounds each request context, it should stay below the
+	// server write timeout so handlers can still answer with a 504.
+	RequestTimeout time.Duration
+	// CORS lets browser clients on other origins call the API, off while
+	// no origin is listed.
+	CORS api.CORSPolicy
+	// Dev enables development only routes, like the e-mail previews.
+	Dev bool
+}
+
+// TLSEnabled reports whether the server terminates TLS itself. Load already
+// checked that the key pair is complete.
+func (s Server) TLSEnabled() bool {
+	return s.TLSCert != "" && s.TLSKey != ""
+}
+
+// Database configures the connection pool and the trip cache in front of it.
+type Database struct {
+	URL Secret
+	// SimpleProtocol keeps prepared statements off the server, set it when
+	// connecting through pgbouncer in transaction pooling mode.
+	SimpleProtocol bool
+	// StatementTimeout caps a single query, so one runaway statement can't
+	// use up the whole request budget.
+	StatementTimeout time.Duration
+	SlowQuery        time.Duration
+	// StartupTimeout is how long to keep retrying the first connection, for
+	// a database still starting up. Zero gives up on the first failure.
+	StartupTimeout time.Duration
+	// TripCacheTTL and TripCacheSize size the in-process trip cache, a zero
+	// value disables it. Keep it off when running more than one replica.
+	TripCacheTTL  time.Duration
+	TripCacheSize int
+	// ReadRetry retries the idempotent reads failing on errors the server
+	// raises while restarting or failing over.
+	ReadRetry pgstore.RetryPolicy
+}
+
+// Mail configures sending e-mails and guarding the mail server.
+type Mail struct {
+	mailpit.Settings
+	// BreakerThreshold consecutive send failures open the mail circuit for
+	// BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// ProbeInterval is how often the mail server is checked, the breaker
+	// stays open while it is unreachable. Zero disables the probe.
+	ProbeInterval time.Duration
+	// ConfirmDelay holds the trip confirmation e-mail back after the trip
+	// is created, giving read replicas time to see the new trip.
+	ConfirmDelay time.Duration
+	// DomainBlocklist is the path of the disposable domains list checked on
+	// invites, empty skips the check.
+	DomainBlocklist string
+	// UnsubscribeKey signs the unsubscribe links. Changing it breaks the
+	// links of the e-mails already sent.
+	UnsubscribeKey Secret
+}
+
+// Auth configures access to the protected routes.
+type Auth struct {
+	// AdminToken enables the /admin routes, empty keeps them disabled.
+	AdminToken Secret
+}
+
+// Jobs configures the background jobs.
+type Jobs struct {
+	OutboxInterval time.Duration
+	// OutboxMaxAttempts is how many times a queued e-mail is tried before it
+	// is moved to the dead letter state.
+	OutboxMaxAttempts int
+	// EmailWorkers is how many outbox e-mails are sent concurrently.
+	EmailWorkers     int
+	ReminderInterval time.Duration
+}
+
+// API configures the handlers.
+type API struct {
+	api.Settings
+	// HolidaysFile is the path of the public holidays listed on trip
+	// creation, empty lists none.
+	HolidaysFile string
+}
+
+// Secret is a setting that must not end up in logs. It prints and marshals
+// redacted, Value gives the real thing.
+type Secret string
+
+const redacted = "[redacted]"
+
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// String redacts the secret, an unset one stays empty so logs still tell
+// the two apart.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return s.String()
+}
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Load reads the configuration from the environment. It doesn't stop at the
+// first problem, the error lists every invalid setting.
+func Load() (Config, error) {
+	var e env
+
+	importance, err := mailpit.ParseImportance(e.str("JOURNEY_MAIL_IMPORTANCE", "normal"))
+	e.check("JOURNEY_MAIL_IMPORTANCE", err)
+
+	cfg := Config{
+		Env:      e.str("JOURNEY_ENV", "production"),
+		LogLevel: e.level("JOURNEY_LOG_LEVEL", "debug"),
+		Server: Server{
+			Addr:           e.str("JOURNEY_ADDR", ":3000"),
+			TLSCert:        e.str("JOURNEY_TLS_CERT", ""),
+			TLSKey:         e.str("JOURNEY_TLS_KEY", ""),
+			RequestTimeout: e.duration("JOURNEY_REQUEST_TIMEOUT", "4s"),
+			CORS: api.CORSPolicy{
+				Origins:     api.ParseCORSList(e.str("JOURNEY_CORS_ORIGINS", "")),
+				Credentials: e.bool("JOURNEY_CORS_CREDENTIALS", "false"),
+				Headers:     api.ParseCORSList(e.str("JOURNEY_CORS_HEADERS", "Content-Type, Authorization, If-None-Match, Accept-Language")),
+				Expose:      api.ParseCORSList(e.str("JOURNEY_CORS_EXPOSE_HEADERS", "ETag")),
+			},
+			Dev: e.bool("JOURNEY_DEV", "false"),
+		},
+		Database: Database{
+			URL:              Secret(e.str("JOURNEY_DATABASE_URL", "user=postgres password=pgpassword host=localhost port=5432 dbname=journey")),
+			SimpleProtocol:   e.bool("JOURNEY_DB_SIMPLE_PROTOCOL", "false"),
+			StatementTimeout: e.duration("JOURNEY_STATEMENT_TIMEOUT", "2s"),
+			SlowQuery:        e.duration("JOURNEY_SLOW_QUERY", "500ms"),
+			StartupTimeout:   e.duration("JOURNEY_DB_STARTUP_TIMEOUT", "30s"),
+			TripCacheTTL:     e.duration("JOURNEY_TRIP_CACHE_TTL", "10s"),
+			TripCacheSize:    e.int("JOURNEY_TRIP_CACHE_SIZE", "1024"),
+			ReadRetry: pgstore.RetryPolicy{
+				// The retries come after the first try.
+				MaxAttempts: e.int("JOURNEY_DB_READ_RETRIES", "2") + 1,
+				Backoff:     e.duration("JOURNEY_DB_READ_RETRY_BACKOFF", "100ms"),
+			},
+		},
+		Mail: Mail{
+			Settings: mailpit.Settings{
+				Host:          e.str("JOURNEY_SMTP_HOST", "localhost"),
+				Port:          e.int("JOURNEY_SMTP_PORT", "1025"),
+				FromAddress:   e.str("JOURNEY_MAIL_FROM", "mailpit@teste.com"),
+				FromName:      e.str("JOURNEY_MAIL_FROM_NAME", ""),
+				SubjectPrefix: e.str("JOURNEY_MAIL_SUBJECT_PREFIX", ""),
+				RatePerSec:    e.float("JOURNEY_EMAIL_RATE_PER_SEC", "5"),
+				Importance:    importance,
+				PublicURL:     strings.TrimSuffix(e.str("JOURNEY_PUBLIC_URL", "http://localhost:3000"), "/"),
+			},
+			BreakerThreshold: e.int("JOURNEY_MAIL_BREAKER_THRESHOLD", "5"),
+			BreakerCooldown:  e.duration("JOURNEY_MAIL_BREAKER_COOLDOWN", "30s"),
+			ProbeInterval:    e.duration("JOURNEY_MAIL_PROBE_INTERVAL", "30s"),
+			ConfirmDelay:     e.duration("JOURNEY_CONFIRM_EMAIL_DELAY", "0s"),
+			DomainBlocklist:  e.str("JOURNEY_EMAIL_DOMAIN_BLOCKLIST", ""),
+			UnsubscribeKey:   Secret(e.str("JOURNEY_UNSUBSCRIBE_KEY", devUnsubscribeKey)),
+		},
+		Auth: Auth{
+			AdminToken: Secret(e.str("JOURNEY_ADMIN_TOKEN", "")),
+		},
+		Jobs: Jobs{
+			OutboxInterval:    e.duration("JOURNEY_OUTBOX_INTERVAL", "5s"),
+			OutboxMaxAttempts: e.int("JOURNEY_OUTBOX_MAX_ATTEMPTS", "5"),
+			EmailWorkers:      e.int("JOURNEY_EMAIL_WORKERS", "2"),
+			ReminderInterval:  e.duration("JOURNEY_REMINDER_INTERVAL", "1m"),
+		},
+		API: API{
+			Settings: api.Settings{
+				MaxTripDays:             e.int("JOURNEY_MAX_TRIP_DAYS", "365"),
+				InviteTTL:               e.duration("JOURNEY_INVITE_TTL", "720h"),
+				MaxPlusOnes:             e.int("JOURNEY_MAX_PLUS_ONES", "5"),
+				DefaultTimezone:         e.str("JOURNEY_DEFAULT_TIMEZONE", "UTC"),
+				LockConfirmedActivities: e.bool("JOURNEY_LOCK_CONFIRMED_ACTIVITIES", "false"),
+			},
+			HolidaysFile: e.str("JOURNEY_HOLIDAYS_FILE", ""),
+		},
+	}
+
+	if e.bool("JOURNEY_AVATARS", "true") {
+		avatars, err := api.NewAvatars(e.str("JOURNEY_AVATAR_DEFAULT", "identicon"))
+		e.check("JOURNEY_AVATAR_DEFAULT", err)
+		cfg.API.Avatars = avatars
+	}
+
+	cfg.validate(&e)
+	if len(e.errs) > 0 {
+		return Config{}, fmt.Errorf("invalid configuration:\n%w", errors.Join(e.errs...))
+	}
+	return cfg, nil
+}
+
+// validate checks the ranges and formats the parsing can't, skipping the
+// settings that already failed to parse.
+func (cfg Config) validate(e *env) {
+	switch cfg.Env {
+	case "development", "staging", "production":
+	default:
+		// A typo like "prod" must not count as a non production deployment.
+		e.check("JOURNEY_ENV", fmt.Errorf("%q is not one of development, staging or production", cfg.Env))
+	}
+	e.check("JOURNEY_ADDR", checkAddr(cfg.Server.Addr))
+	e.check("JOURNEY_SMTP_PORT", checkPort(cfg.Mail.Port))
+
+	if _, err := pgxpool.ParseConfig(cfg.Database.URL.Value()); err != nil {
+		// The parse error quotes the connection string, password included.
+		e.check("JOURNEY_DATABASE_URL", errors.New("not a valid connection string or URL"))
+	}
+	if _, err := mail.ParseAddress(cfg.Mail.FromAddress); err != nil {
+		e.check("JOURNEY_MAIL_FROM", err)
+	}
+	if u, err := url.Parse(cfg.Mail.PublicURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		e.check("JOURNEY_PUBLIC_URL", fmt.Errorf("%q is not an absolute http(s) URL", cfg.Mail.PublicURL))
+	}
+	e.require("JOURNEY_UNSUBSCRIBE_KEY", !cfg.Production() || cfg.Mail.UnsubscribeKey != devUnsubscribeKey, "must be set in production")
+	e.require("JOURNEY_UNSUBSCRIBE_KEY", len(cfg.Mail.UnsubscribeKey) >= 32, "must be at least 32 characters")
+	if _, err := time.LoadLocation(cfg.API.DefaultTimezone); err != nil || cfg.API.DefaultTimezone == "Local" {
+		e.check("JOURNEY_DEFAULT_TIMEZONE", fmt.Errorf("%q is not an IANA time zone", cfg.API.DefaultTimezone))
+	}
+
+	switch {
+	case cfg.Server.TLSCert == "" && cfg.Server.TLSKey == "":
+	case cfg.Server.TLSCert == "" || cfg.Server.TLSKey == "":
+		e.errs = append(e.errs, errors.New("JOURNEY_TLS_CERT and JOURNEY_TLS_KEY must be set together"))
+	default:
+		_, err := os.Stat(cfg.Server.TLSCert)
+		e.check("JOURNEY_TLS_CERT", err)
+		_, err = os.Stat(cfg.Server.TLSKey)
+		e.check("JOURNEY_TLS_KEY", err)
+	}
+
+	e.require("JOURNEY_REQUEST_TIMEOUT", cfg.Server.RequestTimeout > 0, "must be a positive duration")
+	e.require("JOURNEY_STATEMENT_TIMEOUT", cfg.Database.StatementTimeout > 0, "must be a positive duration")
+	e.require("JOURNEY_SLOW_QUERY", cfg.Database.SlowQuery > 0, "must be a positive duration")
+	e.require("JOURNEY_DB_STARTUP_TIMEOUT", cfg.Database.StartupTimeout >= 0, "must be a non negative duration")
+	e.require("JOURNEY_TRIP_CACHE_SIZE", cfg.Database.TripCacheSize >= 0, "must be a non negative number")
+	e.require("JOURNEY_DB_READ_RETRIES", cfg.Database.ReadRetry.MaxAttempts >= 1, "must be a non negative number")
+	e.require("JOURNEY_DB_READ_RETRY_BACKOFF", cfg.Database.ReadRetry.Backoff > 0, "must be a positive duration")
+	e.require("JOURNEY_EMAIL_RATE_PER_SEC", cfg.Mail.RatePerSec > 0, "must be a positive number")
+	e.require("JOURNEY_MAIL_BREAKER_THRESHOLD", cfg.Mail.BreakerThreshold >= 1, "must be a positive integer")
+	e.require("JOURNEY_MAIL_PROBE_INTERVAL", cfg.Mail.ProbeInterval >= 0, "must be a non negative duration")
+	e.require("JOURNEY_CONFIRM_EMAIL_DELAY", cfg.Mail.ConfirmDelay >= 0, "must be a non negative duration")
+	e.require("JOURNEY_OUTBOX_INTERVAL", cfg.Jobs.OutboxInterval > 0, "must be a positive duration")
+	e.require("JOURNEY_OUTBOX_MAX_ATTEMPTS", cfg.Jobs.OutboxMaxAttempts >= 1, "must be a positive integer")
+	e.require("JOURNEY_EMAIL_WORKERS", cfg.Jobs.EmailWorkers >= 1, "must be a positive integer")
+	e.require("JOURNEY_REMINDER_INTERVAL", cfg.Jobs.ReminderInterval > 0, "must be a positive duration")
+	e.require("JOURNEY_MAX_TRIP_DAYS", cfg.API.MaxTripDays > 0, "must be a positive number of days")
+	e.require("JOURNEY_INVITE_TTL", cfg.API.InviteTTL >= 0, "must be a non negative duration")
+	e.require("JOURNEY_MAX_PLUS_ONES", cfg.API.MaxPlusOnes >= 0, "must be a non negative number")
+}
+
+func checkAddr(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("port %q is not a number", port)
+	}
+	return checkPort(n)
+}
+
+func checkPort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port %d is out of the 1-65535 range", port)
+	}
+	return nil
+}
+
+// env reads settings from the environment, collecting the errors instead of
+// returning them.
+type env struct {
+	errs   []error
+	failed map[string]bool
+}
+
+func (e *env) str(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// check records err against key, unless key already failed.
+func (e *env) check(key string, err error) {
+	if err == nil || e.failed[key] {
+		return
+	}
+	if e.failed == nil {
+		e.failed = make(map[string]bool)
+	}
+	e.failed[key] = true
+	e.errs = append(e.errs, fmt.Errorf("invalid %s: %w", key, err))
+}
+
+func (e *env) require(key string, ok bool, msg string) {
+	if !ok {
+		e.check(key, errors.New(msg))
+	}
+}
+
+func (e *env) duration(key, fallback string) time.Duration {
+	d, err := time.ParseDuration(e.str(key, fallback))
+	e.check(key, err)
+	return d
+}
+
+func (e *env) int(key, fallback string) int {
+	n, err := strconv.Atoi(e.str(key, fallback))
+	e.check(key, err)
+	return n
+}
+
+func (e *env) float(key, fallback string) float64 {
+	f, err := strconv.ParseFloat(e.str(key, fallback), 64)
+	e.check(key, err)
+	return f
+}
+
+func (e *env) bool(key, fallback string) bool {
+	b, err := strconv.ParseBool(e.str(key, fallback))
+	e.check(key, err)
+	return b
+}
+
+func (e *env) level(key, fallback string) zapcore.Level {
+	level, err := zapcore.ParseLevel(e.str(key, fallback))
+	e.check(key, err)
+	return level
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		// invalid lists the settings the error must name, none when Load
+		// must succeed.
+		invalid []string
+	}{
+		{"development defaults", nil, nil},
+		{"production without unsubscribe key", map[string]string{"JOURNEY_ENV": "production"}, []string{"JOURNEY_UNSUBSCRIBE_KEY"}},
+		{"production", map[string]string{"JOURNEY_ENV": "production", "JOURNEY_UNSUBSCRIBE_KEY": strings.Repeat("k", 32)}, nil},
+		{"unknown env", map[string]string{"JOURNEY_ENV": "prod"}, []string{"JOURNEY_ENV"}},
+		{"short unsubscribe key", map[string]string{"JOURNEY_UNSUBSCRIBE_KEY": "short"}, []string{"JOURNEY_UNSUBSCRIBE_KEY"}},
+		{"unparsable duration", map[string]string{"JOURNEY_REQUEST_TIMEOUT": "4"}, []string{"JOURNEY_REQUEST_TIMEOUT"}},
+		{"unparsable int", map[string]string{"JOURNEY_SMTP_PORT": "smtp"}, []string{"JOURNEY_SMTP_PORT"}},
+		{"port out of range", map[string]string{"JOURNEY_SMTP_PORT": "70000"}, []string{"JOURNEY_SMTP_PORT"}},
+		{"address without port", map[string]string{"JOURNEY_ADDR": "localhost"}, []string{"JOURNEY_ADDR"}},
+		{"from address", map[string]string{"JOURNEY_MAIL_FROM": "journey"}, []string{"JOURNEY_MAIL_FROM"}},
+		{"relative public url", map[string]string{"JOURNEY_PUBLIC_URL": "journey.example.com"}, []string{"JOURNEY_PUBLIC_URL"}},
+		{"local timezone", map[string]string{"JOURNEY_DEFAULT_TIMEZONE": "Local"}, []string{"JOURNEY_DEFAULT_TIMEZONE"}},
+		{"unknown timezone", map[string]string{"JOURNEY_DEFAULT_TIMEZONE": "Mars/Olympus"}, []string{"JOURNEY_DEFAULT_TIMEZONE"}},
+		{"tls cert alone", map[string]string{"JOURNEY_TLS_CERT": "cert.pem"}, []string{"JOURNEY_TLS_CERT and JOURNEY_TLS_KEY"}},
+		{"missing tls files", map[string]string{"JOURNEY_TLS_CERT": "missing.pem", "JOURNEY_TLS_KEY": "missing.key"}, []string{"JOURNEY_TLS_CERT", "JOURNEY_TLS_KEY"}},
+		{"unknown avatar style", map[string]string{"JOURNEY_AVATAR_DEFAULT": "kitten"}, []string{"JOURNEY_AVATAR_DEFAULT"}},
+		{"avatar style ignored when off", map[string]string{"JOURNEY_AVATARS": "false", "JOURNEY_AVATAR_DEFAULT": "kitten"}, nil},
+		{"zero workers", map[string]string{"JOURNEY_EMAIL_WORKERS": "0"}, []string{"JOURNEY_EMAIL_WORKERS"}},
+		{"negative plus ones", map[string]string{"JOURNEY_MAX_PLUS_ONES": "-1"}, []string{"JOURNEY_MAX_PLUS_ONES"}},
+		{"zero request timeout", map[string]string{"JOURNEY_REQUEST_TIMEOUT": "0s"}, []string{"JOURNEY_REQUEST_TIMEOUT"}},
+		{"negative statement timeout", map[string]string{"JOURNEY_STATEMENT_TIMEOUT": "-2s"}, []string{"JOURNEY_STATEMENT_TIMEOUT"}},
+		{"zero slow query", map[string]string{"JOURNEY_SLOW_QUERY": "0s"}, []string{"JOURNEY_SLOW_QUERY"}},
+		{"zero e-mail rate", map[string]string{"JOURNEY_EMAIL_RATE_PER_SEC": "0"}, []string{"JOURNEY_EMAIL_RATE_PER_SEC"}},
+		{"negative e-mail rate", map[string]string{"JOURNEY_EMAIL_RATE_PER_SEC": "-1.5"}, []string{"JOURNEY_EMAIL_RATE_PER_SEC"}},
+		{"no read retries", map[string]string{"JOURNEY_DB_READ_RETRIES": "0"}, nil},
+		{"negative read retries", map[string]string{"JOURNEY_DB_READ_RETRIES": "-1"}, []string{"JOURNEY_DB_READ_RETRIES"}},
+		{"zero read retry backoff", map[string]string{"JOURNEY_DB_READ_RETRY_BACKOFF": "0s"}, []string{"JOURNEY_DB_READ_RETRY_BACKOFF"}},
+		{
+			"every mistake at once",
+			map[string]string{"JOURNEY_OUTBOX_INTERVAL": "0s", "JOURNEY_MAX_TRIP_DAYS": "0", "JOURNEY_MAIL_BREAKER_THRESHOLD": "0"},
+			[]string{"JOURNEY_OUTBOX_INTERVAL", "JOURNEY_MAX_TRIP_DAYS", "JOURNEY_MAIL_BREAKER_THRESHOLD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JOURNEY_ENV", "development")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := Load()
+			if len(tt.invalid) == 0 {
+				if err != nil {
+					t.Fatalf("Load: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Load succeeded, want %v invalid", tt.invalid)
+			}
+			for _, key := range tt.invalid {
+				if !strings.Contains(err.Error(), key) {
+					t.Errorf("error doesn't name %s:\n%v", key, err)
+				}
+			}
+			if cfg.Env != "" {
+				t.Errorf("Load returned settings along with its error")
+			}
+		})
+	}
+}
+
+func TestLoadReportsEachSettingOnce(t *testing.T) {
+	t.Setenv("JOURNEY_ENV", "development")
+	// Unparsable, so the range check of the zero value must not pile on.
+	t.Setenv("JOURNEY_EMAIL_WORKERS", "two")
+
+	_, err := Load()
+	if n := strings.Count(fmt.Sprint(err), "JOURNEY_EMAIL_WORKERS"); n != 1 {
+		t.Errorf("JOURNEY_EMAIL_WORKERS reported %d times:\n%v", n, err)
+	}
+}
+
+func TestSecretRedacted(t *testing.T) {
+	cfg := Config{Database: Database{URL: "postgres://journey:hunter2@db/journey"}}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		if out := fmt.Sprintf(format, cfg); strings.Contains(out, "hunter2") {
+			t.Errorf("%s prints the password: %s", format, out)
+		}
+	}
+	if unset := cfg.Auth.AdminToken.String(); unset != "" {
+		t.Errorf("unset secret prints %q, want it empty", unset)
+	}
+	if cfg.Database.URL.Value() != "postgres://journey:hunter2@db/journey" {
+		t.Errorf("Value = %q", cfg.Database.URL.Value())
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	t.Setenv("JOURNEY_ENV", "development")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.RequestTimeout != 4*time.Second || cfg.Jobs.OutboxMaxAttempts != 5 || cfg.API.DefaultTimezone != "UTC" {
+		t.Errorf("unexpected defaults %+v", cfg)
+	}
+	if want := (pgstore.RetryPolicy{MaxAttempts: 3, Backoff: 100 * time.Millisecond}); cfg.Database.ReadRetry != want {
+		t.Errorf("read retry %+v, want %+v", cfg.Database.ReadRetry, want)
+	}
+	if cfg.Server.TLSEnabled() || cfg.Production() {
+		t.Errorf("development defaults enable TLS %t, production %t", cfg.Server.TLSEnabled(), cfg.Production())
+	}
+}
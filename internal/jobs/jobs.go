@@ -0,0 +1,167 @@
+package jobs
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"journey/internal/logctx"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// stats holds a map per job with its run, error and panic counts and the
+// duration of its last run.
+var stats = expvar.NewMap("jobs")
+
+// stagger spaces the first runs of the jobs apart, so they don't all hit the
+// database the moment the process starts.
+const stagger = 2 * time.Second
+
+// Job is a task run every Interval. Errors are logged and counted, the job
+// keeps its schedule either way.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Runner runs the registered jobs, each on its own ticker.
+type Runner struct {
+	logger *zap.Logger
+	jobs   []Job
+}
+
+func NewRunner(logger *zap.Logger) *Runner {
+	return &Runner{logger: logger}
+}
+
+// Register adds a job. Names must be unique, they key the metrics and pick
+// the job for RunOnce.
+func (r *Runner) Register(job Job) {
+	for _, registered := range r.jobs {
+		if registered.Name == job.Name {
+			panic("jobs: job registered twice: " + job.Name)
+		}
+	}
+	r.jobs = append(r.jobs, job)
+}
+
+// Names lists the registered jobs in name order.
+func (r *Runner) Names() []string {
+	names := make([]string, len(r.jobs))
+	for i, job := range r.jobs {
+		names[i] = job.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run starts every job, in registration order and stagger apart, and blocks
+// until ctx is done and the runs in progress have returned.
+func (r *Runner) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i, job := range r.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.loop(ctx, job, time.Duration(i)*stagger)
+		}()
+	}
+	wg.Wait()
+}
+
+// RunOnce runs the named job a single time, for operators kicking a job by
+// hand.
+func (r *Runner) RunOnce(ctx context.Context, name string) error {
+	for _, job := range r.jobs {
+		if job.Name == name {
+			return r.run(ctx, job)
+		}
+	}
+	return fmt.Errorf("unknown job %q, expected one of: %s", name, strings.Join(r.Names(), ", "))
+}
+
+func (r *Runner) loop(ctx context.Context, job Job, delay time.Duration) {
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		// The error is already logged, a failed run waits for the next tick
+		// like any other.
+		_ = r.run(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run runs the job once, turning a panic into an error so one bad run
+// doesn't take the process down. Each run gets an ID, logged like a request
+// ID by the job and by the store and mailer calls it makes.
+func (r *Runner) run(ctx context.Context, job Job) (err error) {
+	metrics := jobStats(job.Name)
+	start := time.Now()
+
+	id := uuid.NewString()
+	logger := r.logger.With(zap.String("job_id", id), zap.String("job", job.Name))
+	ctx = logctx.WithJobID(logctx.With(ctx, logger), id)
+
+	defer func() {
+		duration := time.Since(start)
+		metrics.Add("runs", 1)
+		lastDuration := new(expvar.Float)
+		lastDuration.Set(duration.Seconds())
+		metrics.Set("last_duration_seconds", lastDuration)
+
+		if p := recover(); p != nil {
+			metrics.Add("panics", 1)
+			err = fmt.Errorf("jobs: %s panicked: %v", job.Name, p)
+			logger.Error("job panicked", zap.Duration("duration", duration), zap.Any("panic", p), zap.Stack("stack"))
+			return
+		}
+
+		switch {
+		case err != nil && ctx.Err() != nil:
+			// Cut short by shutdown, not a failure of the job.
+		case err != nil:
+			metrics.Add("errors", 1)
+			logger.Error("job failed", zap.Duration("duration", duration), zap.Error(err))
+		default:
+			logger.Debug("job finished", zap.Duration("duration", duration))
+		}
+	}()
+
+	return job.Run(ctx)
+}
+
+var statsMu sync.Mutex
+
+func jobStats(name string) *expvar.Map {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+
+	if m, ok := stats.Get(name).(*expvar.Map); ok {
+		return m
+	}
+	m := new(expvar.Map)
+	stats.Set(name, m)
+	return m
+}
@@ -0,0 +1,39 @@
+// Package logctx carries the logger of the current request or job run in its
+// context, so the store and the mailer log with the same fields as the
+// handler or job that called them.
+package logctx
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type loggerKey struct{}
+
+type jobIDKey struct{}
+
+// With returns a copy of ctx carrying logger.
+func With(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// From returns the logger ctx carries, fallback when it carries none.
+func From(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// WithJobID returns a copy of ctx tagged with the ID of a job run, the
+// background counterpart of a request ID.
+func WithJobID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, jobIDKey{}, id)
+}
+
+// JobID returns the job run ID of ctx, empty outside of a job.
+func JobID(ctx context.Context) string {
+	id, _ := ctx.Value(jobIDKey{}).(string)
+	return id
+}
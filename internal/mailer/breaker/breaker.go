@@ -0,0 +1,238 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"journey/internal/logctx"
+	"journey/internal/pgstore"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ErrOpen is returned without dialing while the breaker is open.
+var ErrOpen = errors.New("breaker: mailer circuit open")
+
+var stats = expvar.NewMap("mail_breaker")
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+type mailer interface {
+	SendConfirmTripEmailToTripOwner(context.Context, uuid.UUID) error
+	SendActivityReminder(context.Context, uuid.UUID, pgtype.UUID) error
+	SendParticipantInvites(context.Context, []uuid.UUID) []error
+	SendRSVPHeadcount(context.Context, uuid.UUID) error
+	SendTripUpdated(context.Context, uuid.UUID, []pgstore.TripChange) error
+	SendOwnerEmailVerify(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error
+	SendOwnerEmailChange(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error
+	SendTestEmail(context.Context, string) error
+}
+
+// Breaker stops calling the wrapped mailer after threshold consecutive
+// failures, failing fast for cooldown before letting a single probe through.
+// A successful probe closes it again, a failed one reopens it.
+type Breaker struct {
+	next      mailer
+	logger    *zap.Logger
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	// unreachable is set while the server probe fails, see Probe.
+	unreachable bool
+}
+
+func New(next mailer, logger *zap.Logger, threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{next: next, logger: logger, threshold: threshold, cooldown: cooldown}
+}
+
+func (b *Breaker) SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
+	return b.call(ctx, func() error { return b.next.SendConfirmTripEmailToTripOwner(ctx, tripID) })
+}
+
+func (b *Breaker) SendActivityReminder(ctx context.Context, activityID uuid.UUID, participantID pgtype.UUID) error {
+	return b.call(ctx, func() error { return b.next.SendActivityReminder(ctx, activityID, participantID) })
+}
+
+// SendParticipantInvites counts as a single send towards the breaker. It
+// failed when every invite did, a single delivered invite shows the server
+// works.
+func (b *Breaker) SendParticipantInvites(ctx context.Context, participantIDs []uuid.UUID) []error {
+	errs := make([]error, len(participantIDs))
+	if err := b.allow(ctx); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	errs = b.next.SendParticipantInvites(ctx, participantIDs)
+	var failed error
+	for _, err := range errs {
+		if err == nil {
+			failed = nil
+			break
+		}
+		failed = err
+	}
+	b.record(ctx, failed)
+	return errs
+}
+
+func (b *Breaker) SendRSVPHeadcount(ctx context.Context, tripID uuid.UUID) error {
+	return b.call(ctx, func() error { return b.next.SendRSVPHeadcount(ctx, tripID) })
+}
+
+func (b *Breaker) SendTripUpdated(ctx context.Context, participantID uuid.UUID, changes []pgstore.TripChange) error {
+	return b.call(ctx, func() error { return b.next.SendTripUpdated(ctx, participantID, changes) })
+}
+
+func (b *Breaker) SendOwnerEmailVerify(ctx context.Context, tripID uuid.UUID, payload pgstore.OwnerEmailPayload) error {
+	return b.call(ctx, func() error { return b.next.SendOwnerEmailVerify(ctx, tripID, payload) })
+}
+
+func (b *Breaker) SendOwnerEmailChange(ctx context.Context, tripID uuid.UUID, payload pgstore.OwnerEmailPayload) error {
+	return b.call(ctx, func() error { return b.next.SendOwnerEmailChange(ctx, tripID, payload) })
+}
+
+// SendTestEmail always reaches the mail server, even with the circuit open, so
+// operators can check a fix. Its outcome still counts towards the breaker.
+func (b *Breaker) SendTestEmail(ctx context.Context, to string) error {
+	err := b.next.SendTestEmail(ctx, to)
+	b.record(ctx, err)
+	return err
+}
+
+func (b *Breaker) call(ctx context.Context, send func() error) error {
+	if err := b.allow(ctx); err != nil {
+		return err
+	}
+
+	err := send()
+	b.record(ctx, err)
+	return err
+}
+
+// State reports the current breaker state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Healthy reports whether mail is currently being delivered.
+func (b *Breaker) Healthy() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state == Closed && !b.unreachable
+}
+
+// Probe checks the mail server once, giving up after timeout, the mail probe
+// job runs it. While the probe fails the breaker reports unhealthy and stays
+// open past its cooldown, a real send would only fail too. Sends aren't
+// stopped by a failing probe alone, they still open the circuit by failing.
+func (b *Breaker) Probe(ctx context.Context, probe func(context.Context) error, timeout time.Duration) {
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	b.setReachable(ctx, probe(probeCtx))
+}
+
+func (b *Breaker) setReachable(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	unreachable := err != nil
+	if unreachable {
+		stats.Add("probe_failed", 1)
+	}
+	if unreachable == b.unreachable {
+		return
+	}
+
+	if unreachable {
+		logctx.From(ctx, b.logger).Warn("mail server probe failed", zap.Error(err))
+	} else {
+		logctx.From(ctx, b.logger).Info("mail server probe recovered")
+	}
+	b.unreachable = unreachable
+}
+
+func (b *Breaker) allow(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if time.Since(b.openedAt) < b.cooldown || b.unreachable {
+			stats.Add("rejected", 1)
+			return ErrOpen
+		}
+		b.transition(ctx, HalfOpen)
+		fallthrough
+	case HalfOpen:
+		if b.probing {
+			stats.Add("rejected", 1)
+			return ErrOpen
+		}
+		b.probing = true
+	}
+
+	return nil
+}
+
+func (b *Breaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		if b.state != Closed {
+			b.transition(ctx, Closed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == HalfOpen || b.failures >= b.threshold {
+		b.openedAt = time.Now()
+		b.transition(ctx, Open)
+	}
+}
+
+// transition must be called with mu held. The change is logged with the
+// logger of ctx, naming the send or probe that caused it.
+func (b *Breaker) transition(ctx context.Context, to State) {
+	if b.state == to {
+		return
+	}
+
+	logctx.From(ctx, b.logger).Warn("mail circuit breaker state changed", zap.Stringer("from", b.state), zap.Stringer("to", to))
+	stats.Add(to.String(), 1)
+	b.state = to
+}
@@ -0,0 +1,248 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+var errSMTP = errors.New("smtp: connection refused")
+
+// fakeMailer fails every send with err and counts the sends reaching it.
+type fakeMailer struct {
+	err   error
+	sends int
+}
+
+func (m *fakeMailer) send() error {
+	m.sends++
+	return m.err
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToTripOwner(context.Context, uuid.UUID) error {
+	return m.send()
+}
+
+func (m *fakeMailer) SendActivityReminder(context.Context, uuid.UUID, pgtype.UUID) error {
+	return m.send()
+}
+
+func (m *fakeMailer) SendParticipantInvites(_ context.Context, ids []uuid.UUID) []error {
+	errs := make([]error, len(ids))
+	for i := range errs {
+		errs[i] = m.send()
+	}
+	return errs
+}
+
+func (m *fakeMailer) SendRSVPHeadcount(context.Context, uuid.UUID) error { return m.send() }
+
+func (m *fakeMailer) SendTripUpdated(context.Context, uuid.UUID, []pgstore.TripChange) error {
+	return m.send()
+}
+
+func (m *fakeMailer) SendOwnerEmailVerify(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error {
+	return m.send()
+}
+
+func (m *fakeMailer) SendOwnerEmailChange(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error {
+	return m.send()
+}
+
+func (m *fakeMailer) SendTestEmail(context.Context, string) error { return m.send() }
+
+func TestBreakerTransitions(t *testing.T) {
+	const cooldown = time.Minute
+
+	// A step sends once with the mailer failing or not, after letting the
+	// cooldown pass when wait is set.
+	type step struct {
+		fail    bool
+		wait    bool
+		wantErr error
+		want    State
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+		sends int
+	}{
+		{
+			name:  "closed below the threshold",
+			steps: []step{{fail: true, wantErr: errSMTP, want: Closed}, {fail: true, wantErr: errSMTP, want: Closed}},
+			sends: 2,
+		},
+		{
+			name: "success resets the failures",
+			steps: []step{
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+			},
+			sends: 4,
+		},
+		{
+			name: "opens at the threshold and fails fast",
+			steps: []step{
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Open},
+				{wantErr: ErrOpen, want: Open},
+			},
+			sends: 3,
+		},
+		{
+			name: "probe closes",
+			steps: []step{
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Open},
+				{wait: true, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+			},
+			sends: 5,
+		},
+		{
+			name: "failed probe reopens at once",
+			steps: []step{
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Closed},
+				{fail: true, wantErr: errSMTP, want: Open},
+				{wait: true, fail: true, wantErr: errSMTP, want: Open},
+				{wantErr: ErrOpen, want: Open},
+			},
+			sends: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &fakeMailer{}
+			b := New(next, zap.NewNop(), 3, cooldown)
+
+			for i, s := range tt.steps {
+				if s.wait {
+					b.mu.Lock()
+					b.openedAt = b.openedAt.Add(-cooldown)
+					b.mu.Unlock()
+				}
+				next.err = nil
+				if s.fail {
+					next.err = errSMTP
+				}
+
+				if err := b.SendRSVPHeadcount(context.Background(), uuid.New()); err != s.wantErr {
+					t.Fatalf("step %d: error %v, want %v", i, err, s.wantErr)
+				}
+				if got := b.State(); got != s.want {
+					t.Fatalf("step %d: state %s, want %s", i, got, s.want)
+				}
+			}
+			if next.sends != tt.sends {
+				t.Errorf("%d sends reached the mailer, want %d", next.sends, tt.sends)
+			}
+		})
+	}
+}
+
+func TestBreakerHalfOpenLetsOneProbeThrough(t *testing.T) {
+	b := New(&fakeMailer{}, zap.NewNop(), 1, time.Minute)
+	b.state = Open
+	b.openedAt = time.Now().Add(-time.Hour)
+
+	if err := b.allow(context.Background()); err != nil {
+		t.Fatalf("probe refused: %v", err)
+	}
+	if b.State() != HalfOpen {
+		t.Fatalf("state %s while probing, want half-open", b.State())
+	}
+	if err := b.allow(context.Background()); err != ErrOpen {
+		t.Fatalf("second send while probing: %v, want ErrOpen", err)
+	}
+}
+
+func TestBreakerUnreachableStaysOpen(t *testing.T) {
+	next := &fakeMailer{}
+	b := New(next, zap.NewNop(), 1, time.Minute)
+	b.state = Open
+	b.openedAt = time.Now().Add(-time.Hour)
+
+	b.Probe(context.Background(), func(context.Context) error { return errSMTP }, time.Second)
+	if b.Healthy() {
+		t.Error("healthy while the probe fails")
+	}
+	if err := b.SendRSVPHeadcount(context.Background(), uuid.New()); err != ErrOpen {
+		t.Fatalf("send past the cooldown with the server down: %v, want ErrOpen", err)
+	}
+
+	b.Probe(context.Background(), func(context.Context) error { return nil }, time.Second)
+	if err := b.SendRSVPHeadcount(context.Background(), uuid.New()); err != nil {
+		t.Fatalf("send after the probe recovered: %v", err)
+	}
+	if !b.Healthy() || next.sends != 1 {
+		t.Errorf("healthy %t after %d sends, want healthy after 1", b.Healthy(), next.sends)
+	}
+}
+
+func TestBreakerTestEmailBypassesOpenCircuit(t *testing.T) {
+	next := &fakeMailer{}
+	b := New(next, zap.NewNop(), 1, time.Minute)
+	b.state = Open
+	b.openedAt = time.Now()
+
+	if err := b.SendTestEmail(context.Background(), "ops@example.com"); err != nil {
+		t.Fatalf("test e-mail: %v", err)
+	}
+	if next.sends != 1 || b.State() != Closed {
+		t.Errorf("%d sends and state %s, want 1 and closed", next.sends, b.State())
+	}
+}
+
+func TestBreakerParticipantInvites(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []error
+		want    State
+	}{
+		{"all delivered", []error{nil, nil}, Closed},
+		{"one delivered", []error{errSMTP, nil}, Closed},
+		{"none delivered", []error{errSMTP, errSMTP}, Open},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &invitesMailer{results: tt.results}
+			b := New(next, zap.NewNop(), 1, time.Minute)
+
+			ids := make([]uuid.UUID, len(tt.results))
+			errs := b.SendParticipantInvites(context.Background(), ids)
+			for i, err := range errs {
+				if err != tt.results[i] {
+					t.Errorf("invite %d: %v, want %v", i, err, tt.results[i])
+				}
+			}
+			if got := b.State(); got != tt.want {
+				t.Errorf("state %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// invitesMailer answers the invites with fixed results.
+type invitesMailer struct {
+	fakeMailer
+	results []error
+}
+
+func (m *invitesMailer) SendParticipantInvites(context.Context, []uuid.UUID) []error {
+	return m.results
+}
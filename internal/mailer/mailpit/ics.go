@@ -0,0 +1,132 @@
+package mailpit
+
+import (
+	"fmt"
+	"journey/internal/pgstore"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/wneessen/go-mail"
+)
+
+// calendarRequest marks an iCalendar part as an invitation the recipient can
+// accept or decline, instead of a file to import.
+const calendarRequest = mail.ContentType("text/calendar; method=REQUEST")
+
+// icsLineLimit is the longest a content line may be, in octets, before it is
+// folded (RFC 5545, section 3.1).
+const icsLineLimit = 75
+
+// attachCalendarInvite adds the trip as a calendar invitation, both as an
+// alternative part, which Outlook and Gmail show with Accept and Decline
+// buttons, and as an invite.ics attachment for clients that only import
+// files. Trips without dates have nothing to put in a calendar.
+func attachCalendarInvite(msg *mail.Msg, trip pgstore.Trip, participant pgstore.Participant) error {
+	if !trip.StartsAt.Valid || !trip.EndsAt.Valid {
+		return nil
+	}
+
+	invite := tripInvite(trip, participant, time.Now())
+	msg.AddAlternativeString(calendarRequest, invite)
+	return msg.AttachReader("invite.ics", strings.NewReader(invite), mail.WithFileContentType(calendarRequest))
+}
+
+// tripInvite renders the invitation of one participant. The UID comes from the
+// participant, so every invite and update they get is the same calendar
+// entry, and SEQUENCE is the trip version, which grows on every change so
+// calendars replace the entry rather than ignore the update.
+func tripInvite(trip pgstore.Trip, participant pgstore.Participant, now time.Time) string {
+	var b strings.Builder
+	line := func(name, value string) {
+		b.WriteString(foldICSLine(name + ":" + value))
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//journey//trip invites//PT")
+	line("METHOD", "REQUEST")
+	line("BEGIN", "VEVENT")
+	line("UID", participant.ID.String()+"@journey")
+	line("SEQUENCE", fmt.Sprint(trip.Version))
+	line("DTSTAMP", now.UTC().Format(icsDateTime))
+
+	start, end := trip.StartsAt.Time.UTC(), trip.EndsAt.Time.UTC()
+	if isMidnight(start) && isMidnight(end) {
+		// Date-only trips are all-day events, DTEND is exclusive.
+		line("DTSTART;VALUE=DATE", start.Format(icsDate))
+		line("DTEND;VALUE=DATE", end.AddDate(0, 0, 1).Format(icsDate))
+	} else {
+		line("DTSTART", start.Format(icsDateTime))
+		line("DTEND", end.Format(icsDateTime))
+	}
+
+	line("SUMMARY", escapeICSText("Viagem para "+trip.Destination))
+	if trip.InviteMessage.Valid {
+		line("DESCRIPTION", escapeICSText(trip.InviteMessage.String))
+	}
+	line("ORGANIZER;CN="+quoteICSParam(trip.OwnerName), "mailto:"+trip.OwnerEmail)
+
+	status := "NEEDS-ACTION"
+	if participant.IsConfirmed {
+		status = "ACCEPTED"
+	}
+	attendee := "ATTENDEE;ROLE=REQ-PARTICIPANT;PARTSTAT=" + status + ";RSVP=TRUE"
+	if participant.Name.Valid {
+		attendee += ";CN=" + quoteICSParam(participant.Name.String)
+	}
+	line(attendee, "mailto:"+participant.Email)
+
+	line("END", "VEVENT")
+	line("END", "VCALENDAR")
+	return b.String()
+}
+
+const (
+	icsDate     = "20060102"
+	icsDateTime = "20060102T150405Z"
+)
+
+func isMidnight(t time.Time) bool {
+	return t.Equal(t.Truncate(24 * time.Hour))
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// quoteICSParam quotes a parameter value such as a CN, which can't hold
+// double quotes or line breaks even when quoted.
+func quoteICSParam(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '"' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, s)
+	return `"` + s + `"`
+}
+
+// foldICSLine ends the content line with CRLF, folding it into continuation
+// lines starting with a space when it is too long. It never splits a
+// multi-byte character.
+func foldICSLine(s string) string {
+	var b strings.Builder
+	limit := icsLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// The leading space counts towards the continuation line.
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+	return b.String()
+}
@@ -0,0 +1,246 @@
+package mailpit
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// icsProperty is one unfolded content line of an iCalendar object.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICS reads an iCalendar object the way RFC 5545 describes it, failing
+// the test on lines a calendar client would reject.
+func parseICS(t *testing.T, ics string) []icsProperty {
+	t.Helper()
+
+	if !strings.HasSuffix(ics, "\r\n") {
+		t.Fatalf("object doesn't end with CRLF")
+	}
+	physical := strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n")
+
+	var lines []string
+	for _, line := range physical {
+		if len(line) > icsLineLimit {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a character: %q", line)
+		}
+		if strings.ContainsAny(line, "\r\n") {
+			t.Errorf("bare line break in %q", line)
+		}
+		if rest, ok := strings.CutPrefix(line, " "); ok && len(lines) > 0 {
+			lines[len(lines)-1] += rest
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	props := make([]icsProperty, 0, len(lines))
+	for _, line := range lines {
+		// The value starts at the first colon outside a quoted parameter.
+		quoted, colon := false, -1
+		for i, r := range line {
+			if r == '"' {
+				quoted = !quoted
+			}
+			if r == ':' && !quoted {
+				colon = i
+				break
+			}
+		}
+		if colon < 0 {
+			t.Fatalf("line without a value: %q", line)
+		}
+
+		prop := icsProperty{params: make(map[string]string), value: line[colon+1:]}
+		head := line[:colon]
+		for i, part := range splitOutsideQuotes(head) {
+			if i == 0 {
+				prop.name = part
+				continue
+			}
+			name, value, ok := strings.Cut(part, "=")
+			if !ok {
+				t.Fatalf("parameter without a value: %q", part)
+			}
+			prop.params[name] = strings.Trim(value, `"`)
+		}
+		props = append(props, prop)
+	}
+	return props
+}
+
+func splitOutsideQuotes(s string) []string {
+	var parts []string
+	quoted, start := false, 0
+	for i, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ';' && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescapeICSText undoes escapeICSText.
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n").Replace(s)
+}
+
+func property(props []icsProperty, name string) (icsProperty, bool) {
+	for _, prop := range props {
+		if prop.name == name {
+			return prop, true
+		}
+	}
+	return icsProperty{}, false
+}
+
+func TestTripInvite(t *testing.T) {
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	participantID := uuid.MustParse("0190b5a0-0000-7000-8000-000000000003")
+
+	tests := []struct {
+		name        string
+		trip        pgstore.Trip
+		participant pgstore.Participant
+		want        map[string]string
+		params      map[string]map[string]string
+	}{
+		{
+			name: "all day",
+			trip: pgstore.Trip{
+				Destination: "Lisboa",
+				OwnerName:   "Ana",
+				OwnerEmail:  "ana@example.com",
+				StartsAt:    pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 0, 0, 0, 0, time.UTC), Valid: true},
+				EndsAt:      pgtype.Timestamptz{Time: time.Date(2030, 7, 15, 0, 0, 0, 0, time.UTC), Valid: true},
+				Version:     1,
+			},
+			participant: pgstore.Participant{ID: participantID, Email: "bia@example.com"},
+			want: map[string]string{
+				"METHOD":    "REQUEST",
+				"UID":       participantID.String() + "@journey",
+				"SEQUENCE":  "1",
+				"DTSTAMP":   "20300601T120000Z",
+				"DTSTART":   "20300710",
+				"DTEND":     "20300716",
+				"SUMMARY":   "Viagem para Lisboa",
+				"ORGANIZER": "mailto:ana@example.com",
+				"ATTENDEE":  "mailto:bia@example.com",
+			},
+			params: map[string]map[string]string{
+				"DTSTART":   {"VALUE": "DATE"},
+				"ORGANIZER": {"CN": "Ana"},
+				"ATTENDEE":  {"PARTSTAT": "NEEDS-ACTION", "RSVP": "TRUE", "ROLE": "REQ-PARTICIPANT"},
+			},
+		},
+		{
+			name: "timed and updated",
+			trip: pgstore.Trip{
+				Destination: "Porto",
+				OwnerName:   "Ana",
+				OwnerEmail:  "ana@example.com",
+				StartsAt:    pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 9, 30, 0, 0, time.UTC), Valid: true},
+				EndsAt:      pgtype.Timestamptz{Time: time.Date(2030, 7, 12, 18, 0, 0, 0, time.UTC), Valid: true},
+				Version:     4,
+			},
+			participant: pgstore.Participant{ID: participantID, Email: "bia@example.com", IsConfirmed: true},
+			want: map[string]string{
+				"UID":      participantID.String() + "@journey",
+				"SEQUENCE": "4",
+				"DTSTART":  "20300710T093000Z",
+				"DTEND":    "20300712T180000Z",
+			},
+			params: map[string]map[string]string{
+				"ATTENDEE": {"PARTSTAT": "ACCEPTED"},
+			},
+		},
+		{
+			name: "text to escape and fold",
+			trip: pgstore.Trip{
+				Destination:   "São Paulo, Rio; e uma volta longa pelo litoral até Florianópolis",
+				OwnerName:     `Ana "Aninha" Souza`,
+				OwnerEmail:    "ana@example.com",
+				StartsAt:      pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 0, 0, 0, 0, time.UTC), Valid: true},
+				EndsAt:        pgtype.Timestamptz{Time: time.Date(2030, 7, 15, 0, 0, 0, 0, time.UTC), Valid: true},
+				InviteMessage: pgtype.Text{String: "Tragam protetor,\nchapéu e boa vontade\\", Valid: true},
+				Version:       1,
+			},
+			participant: pgstore.Participant{ID: participantID, Email: "bia@example.com", Name: pgtype.Text{String: "Bia; Lima", Valid: true}},
+			want: map[string]string{
+				"SUMMARY":     "Viagem para São Paulo, Rio; e uma volta longa pelo litoral até Florianópolis",
+				"DESCRIPTION": "Tragam protetor,\nchapéu e boa vontade\\",
+			},
+			params: map[string]map[string]string{
+				"ORGANIZER": {"CN": "Ana Aninha Souza"},
+				"ATTENDEE":  {"CN": "Bia; Lima"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			props := parseICS(t, tripInvite(tt.trip, tt.participant, now))
+
+			if first, last := props[0], props[len(props)-1]; first.value != "VCALENDAR" || last.value != "VCALENDAR" {
+				t.Errorf("object starts with %v and ends with %v", first, last)
+			}
+			for name, want := range tt.want {
+				prop, ok := property(props, name)
+				if !ok {
+					t.Errorf("%s missing", name)
+					continue
+				}
+				if got := unescapeICSText(prop.value); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			for name, params := range tt.params {
+				prop, _ := property(props, name)
+				for param, want := range params {
+					if got := prop.params[param]; got != want {
+						t.Errorf("%s;%s = %q, want %q", name, param, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFoldICSLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"short", "SUMMARY:Lisboa"},
+		{"at the limit", "SUMMARY:" + strings.Repeat("a", icsLineLimit-len("SUMMARY:"))},
+		{"long", "DESCRIPTION:" + strings.Repeat("a", 200)},
+		{"multi-byte", "SUMMARY:" + strings.Repeat("ção", 60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := foldICSLine(tt.line)
+			props := parseICS(t, folded)
+			if len(props) != 1 || props[0].name+":"+props[0].value != tt.line {
+				t.Errorf("folded %q unfolds to %v", folded, props)
+			}
+		})
+	}
+}
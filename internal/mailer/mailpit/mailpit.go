@@ -2,59 +2,599 @@ package mailpit
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+	"journey/internal/logctx"
 	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type store interface {
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	GetActivity(context.Context, uuid.UUID) (pgstore.Activity, error)
+	GetTripParticipantStats(context.Context, uuid.UUID) (pgstore.GetTripParticipantStatsRow, error)
+	GetSuppressedEmails(context.Context, []string) ([]string, error)
+	SuppressEmail(context.Context, pgstore.SuppressEmailParams) (pgstore.EmailSuppression, error)
+	GetUnsubscribedEmails(context.Context, pgstore.GetUnsubscribedEmailsParams) ([]string, error)
+}
+
+// suppressedSends counts the messages skipped because their recipient is on
+// the suppression list.
+var suppressedSends = expvar.NewInt("mail_suppressed")
+
+// sendWorkers bounds how many SMTP sessions a batch send opens at once.
+const sendWorkers = 4
+
+// Settings is the SMTP server the mailer delivers through and how outgoing
+// messages are addressed. SubjectPrefix, like "[staging] ", is prepended to
+// every subject so messages from other environments stand out.
+type Settings struct {
+	Host          string
+	Port          int
+	FromAddress   string
+	FromName      string
+	SubjectPrefix string
+	// RatePerSec caps how many messages are sent per second, zero or less
+	// sends as fast as the server accepts them.
+	RatePerSec float64
+	// Importance is set on the messages that have none of their own.
+	Importance mail.Importance
+	// PublicURL is where recipients reach the API, like
+	// https://journey.example.com, the base of the unsubscribe links.
+	PublicURL string
+}
+
+// Reminders are time sensitive and flagged as such, confirmations are
+// routine whatever the configured default.
+const (
+	reminderImportance     = mail.ImportanceHigh
+	confirmationImportance = mail.ImportanceNormal
+)
+
+// ParseImportance reads an importance name: low, normal, high, non-urgent or
+// urgent.
+func ParseImportance(s string) (mail.Importance, error) {
+	switch s {
+	case "low":
+		return mail.ImportanceLow, nil
+	case "normal":
+		return mail.ImportanceNormal, nil
+	case "high":
+		return mail.ImportanceHigh, nil
+	case "non-urgent":
+		return mail.ImportanceNonUrgent, nil
+	case "urgent":
+		return mail.ImportanceUrgent, nil
+	}
+	return 0, fmt.Errorf("unknown importance %q", s)
 }
 
 type Mailpit struct {
-	store store
+	store       store
+	logger      *zap.Logger
+	settings    *atomic.Pointer[Settings]
+	limiter     *rateLimiter
+	unsubscribe unsubscribe.Signer
 }
 
-func NewMailpit(pool *pgxpool.Pool) Mailpit {
-	return Mailpit{pgstore.New(pool)}
+func NewMailpit(pool *pgxpool.Pool, readRetry pgstore.RetryPolicy, logger *zap.Logger, settings Settings, trips *pgstore.TripCache, unsubscribes unsubscribe.Signer) Mailpit {
+	store := pgstore.NewCached(pgstore.NewRetrying(pool, readRetry), trips)
+	mp := Mailpit{store, logger, new(atomic.Pointer[Settings]), new(rateLimiter), unsubscribes}
+	mp.SetSettings(settings)
+	return mp
 }
 
-func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
-	ctx := context.Background()
+// SetSettings swaps the SMTP settings used by subsequent sends.
+func (mp Mailpit) SetSettings(settings Settings) {
+	mp.settings.Store(&settings)
+	mp.limiter.setRate(settings.RatePerSec)
+}
+
+func (mp Mailpit) SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
 	trip, err := mp.store.GetTrip(ctx, tripID)
 	if err != nil {
 		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
+	msg, err := mp.newMsgWithImportance(trip.OwnerEmail, "Confirme sua viagem", confirmationImportance)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendConfirmTripEmailToTripOwner: %w", err)
+	}
+	if err := setReplyTo(msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendConfirmTripEmailToTripOwner: %w", err)
+	}
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(templateConfirmTripOwner), newTemplateData(trip)); err != nil {
+		return fmt.Errorf("mailpit: failed to render email SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	return mp.sendSession(ctx, []*mail.Msg{msg})[0]
+}
+
+// SendParticipantInvites invites the participants to confirm their
+// presence, the outbox sends the invites it claimed together. Messages go out
+// over a few SMTP sessions shared by the batch instead of one per invite, and
+// the error of each invite is returned in the order of participantIDs, so
+// each can be retried on its own.
+func (mp Mailpit) SendParticipantInvites(ctx context.Context, participantIDs []uuid.UUID) []error {
+	errs := make([]error, len(participantIDs))
+	msgs := make([]*mail.Msg, 0, len(participantIDs))
+	pending := make([]int, 0, len(participantIDs))
+	for i, participantID := range participantIDs {
+		participant, err := mp.store.GetParticipant(ctx, participantID)
+		if err != nil {
+			errs[i] = fmt.Errorf("mailpit: failed to get participant for SendParticipantInvites: %w", err)
+			continue
+		}
+
+		trip, err := mp.store.GetTrip(ctx, participant.TripID)
+		if err != nil {
+			errs[i] = fmt.Errorf("mailpit: failed to get trip for SendParticipantInvites: %w", err)
+			continue
+		}
+
+		msg, err := mp.participantInviteMsg(trip, participant)
+		if err != nil {
+			errs[i] = fmt.Errorf("mailpit: failed to build email SendParticipantInvites: %w", err)
+			continue
+		}
+
+		msgs = append(msgs, msg)
+		pending = append(pending, i)
+	}
+
+	workers := min(sendWorkers, len(msgs))
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		// Each worker owns every workers-th message, so errors never overlap.
+		var batch []*mail.Msg
+		var slots []int
+		for i := w; i < len(msgs); i += workers {
+			batch = append(batch, msgs[i])
+			slots = append(slots, pending[i])
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, err := range mp.sendSession(ctx, batch) {
+				errs[slots[i]] = err
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// participantInviteMsg renders the invite of one participant, with the plain
+// text part first, the HTML alternative and the calendar invitation. A note
+// sent with a later invite replaces the one set on the trip.
+func (mp Mailpit) participantInviteMsg(trip pgstore.Trip, participant pgstore.Participant) (*mail.Msg, error) {
+	msg, err := mp.newMsgWithImportance(participant.Email, "Confirme sua presença na viagem", confirmationImportance)
+	if err != nil {
+		return nil, err
+	}
+	if err := setReplyTo(msg, trip); err != nil {
+		return nil, err
+	}
+
+	data := newTemplateData(trip)
+	if participant.InviteMessage.Valid {
+		data.InviteMessage = participant.InviteMessage.String
+	}
+
+	if err := msg.SetBodyTextTemplate(lookupTextTemplate(templateConfirmParticipant), data); err != nil {
+		return nil, fmt.Errorf("failed to render text: %w", err)
+	}
+
+	if err := msg.AddAlternativeHTMLTemplate(lookupTemplate(templateConfirmParticipant), data); err != nil {
+		return nil, fmt.Errorf("failed to render html: %w", err)
+	}
+
+	if err := attachCalendarInvite(msg, trip, participant); err != nil {
+		return nil, fmt.Errorf("failed to attach calendar invite: %w", err)
+	}
+
+	return msg, nil
+}
+
+// SendActivityReminder reminds one recipient that the activity is coming up,
+// the participant or the trip owner when participantID is null. Participants
+// who are no longer confirmed and recipients who unsubscribed from reminders
+// get nothing.
+func (mp Mailpit) SendActivityReminder(ctx context.Context, activityID uuid.UUID, participantID pgtype.UUID) error {
+	activity, err := mp.store.GetActivity(ctx, activityID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get activity for SendActivityReminder: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, activity.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendActivityReminder: %w", err)
+	}
+
+	recipient := trip.OwnerEmail
+	if participantID.Valid {
+		participant, err := mp.store.GetParticipant(ctx, participantID.Bytes)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to get participant for SendActivityReminder: %w", err)
+		}
+		if !participant.IsConfirmed || participant.TripID != trip.ID {
+			logctx.From(ctx, mp.logger).Debug("activity reminder skipped, participant not confirmed")
+			return nil
+		}
+		recipient = participant.Email
+	}
+
+	subscribed, err := mp.subscribed(ctx, unsubscribe.CategoryReminders, []string{recipient})
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get preferences for SendActivityReminder: %w", err)
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	data := newTemplateData(trip)
+	data.Activity = activity.Title
+	data.ActivityAt = activity.OccursAt.Time.UTC().Format(time.DateTime)
+
+	msg, err := mp.newMsgWithImportance(recipient, "Lembrete: "+activity.Title, reminderImportance)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendActivityReminder: %w", err)
+	}
+	data.UnsubscribeURL = mp.setUnsubscribe(msg, recipient, unsubscribe.CategoryReminders)
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(templateActivityReminder), data); err != nil {
+		return fmt.Errorf("mailpit: failed to render email SendActivityReminder: %w", err)
+	}
+
+	return mp.sendSession(ctx, []*mail.Msg{msg})[0]
+}
+
+// SendRSVPHeadcount tells the trip owner how many participants confirmed
+// before the RSVP deadline.
+func (mp Mailpit) SendRSVPHeadcount(ctx context.Context, tripID uuid.UUID) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendRSVPHeadcount: %w", err)
+	}
+
+	stats, err := mp.store.GetTripParticipantStats(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant stats for SendRSVPHeadcount: %w", err)
+	}
+
+	data := newTemplateData(trip)
+	data.Invited = stats.Invited
+	data.Confirmed = stats.Confirmed
+	data.Headcount = stats.Headcount
+
+	msg, err := mp.newMsg(trip.OwnerEmail, "Lista final: "+trip.Destination)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendRSVPHeadcount: %w", err)
+	}
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(templateRSVPHeadcount), data); err != nil {
+		return fmt.Errorf("mailpit: failed to render email SendRSVPHeadcount: %w", err)
+	}
+
+	return mp.sendSession(ctx, []*mail.Msg{msg})[0]
+}
+
+// SendTripUpdated tells a confirmed participant what changed in the trip.
+func (mp Mailpit) SendTripUpdated(ctx context.Context, participantID uuid.UUID, changes []pgstore.TripChange) error {
+	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendTripUpdated: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, participant.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripUpdated: %w", err)
+	}
+
+	data := newTemplateData(trip)
+	data.Changes = newTemplateChanges(changes)
+
+	msg, err := mp.newMsg(participant.Email, "Sua viagem mudou: "+trip.Destination)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendTripUpdated: %w", err)
+	}
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(templateTripUpdated), data); err != nil {
+		return fmt.Errorf("mailpit: failed to render email SendTripUpdated: %w", err)
+	}
+
+	// The same calendar entry with a higher sequence, so calendars that took
+	// the invite move it to the new dates.
+	if err := attachCalendarInvite(msg, trip, participant); err != nil {
+		return fmt.Errorf("mailpit: failed to attach calendar invite SendTripUpdated: %w", err)
+	}
+
+	return mp.sendSession(ctx, []*mail.Msg{msg})[0]
+}
+
+// SendOwnerEmailVerify sends the link that makes payload.NewEmail the owner
+// address of the trip.
+func (mp Mailpit) SendOwnerEmailVerify(ctx context.Context, tripID uuid.UUID, payload pgstore.OwnerEmailPayload) error {
+	return mp.sendOwnerEmail(ctx, "SendOwnerEmailVerify", tripID, payload, templateOwnerEmailVerify, "Confirme o novo e-mail da viagem")
+}
+
+// SendOwnerEmailChange tells the current owner address that a change to
+// payload.NewEmail was asked.
+func (mp Mailpit) SendOwnerEmailChange(ctx context.Context, tripID uuid.UUID, payload pgstore.OwnerEmailPayload) error {
+	return mp.sendOwnerEmail(ctx, "SendOwnerEmailChange", tripID, payload, templateOwnerEmailChange, "Pedido de troca do e-mail da viagem")
+}
+
+// sendOwnerEmail renders the template of an owner e-mail change e-mail,
+// op names the caller in errors.
+func (mp Mailpit) sendOwnerEmail(ctx context.Context, op string, tripID uuid.UUID, payload pgstore.OwnerEmailPayload, tpl, subject string) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for %s: %w", op, err)
+	}
+
+	data := newTemplateData(trip)
+	data.NewEmail = payload.NewEmail
+	if payload.Token != "" {
+		data.VerifyPath = ownerEmailVerifyPath(trip.ID, payload.Token)
+	}
+
+	msg, err := mp.newMsg(payload.To, subject)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email %s: %w", op, err)
+	}
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(tpl), data); err != nil {
+		return fmt.Errorf("mailpit: failed to render email %s: %w", op, err)
+	}
+
+	return mp.sendSession(ctx, []*mail.Msg{msg})[0]
+}
+
+// SendTestEmail sends a canned message to the given address so operators can
+// check the SMTP settings. The dial or send error is returned unwrapped, the
+// caller shows it as the server reported it. It is the one send ignoring the
+// suppression list, the operator picked the address.
+func (mp Mailpit) SendTestEmail(ctx context.Context, to string) error {
+	msg, err := mp.newMsg(to, "Journey: e-mail de teste")
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email SendTestEmail: %w", err)
+	}
+	if err := msg.SetBodyHTMLTemplate(lookupTemplate(templateTestEmail), templateData{}); err != nil {
+		return fmt.Errorf("mailpit: failed to render email SendTestEmail: %w", err)
+	}
+
+	client, err := mp.newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := mp.limiter.wait(ctx); err != nil {
+		return err
+	}
+
+	return client.DialAndSend(msg)
+}
+
+// sendSession delivers msgs over a single SMTP connection, returning the
+// error of each message in order. Messages to a suppressed address are
+// skipped without an error, and an address the server rejects for good is
+// suppressed for the next sends.
+func (mp Mailpit) sendSession(ctx context.Context, msgs []*mail.Msg) []error {
+	errs := make([]error, len(msgs))
+	// failFrom fails every message not sent yet.
+	failFrom := func(from int, err error) []error {
+		for i := from; i < len(errs); i++ {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	suppressed, err := mp.suppressed(ctx, msgs)
+	if err != nil {
+		return failFrom(0, fmt.Errorf("mailpit: failed to check suppressed emails: %w", err))
+	}
+	if len(suppressed) == len(msgs) {
+		return errs
+	}
+
+	client, err := mp.newClient()
+	if err != nil {
+		return failFrom(0, fmt.Errorf("mailpit: failed to create email client: %w", err))
+	}
+
+	if err := client.DialWithContext(ctx); err != nil {
+		return failFrom(0, fmt.Errorf("mailpit: failed to dial smtp server: %w", err))
+	}
+	defer func() { _ = client.Close() }()
+
+	// Messages go out one at a time to honour the send rate. A failed
+	// message doesn't stop the session, only a lost connection fails the
+	// ones left.
+	for i, msg := range msgs {
+		if suppressed[i] {
+			continue
+		}
+
+		if err := mp.limiter.wait(ctx); err != nil {
+			return failFrom(i, fmt.Errorf("mailpit: failed to wait for send rate: %w", err))
+		}
+
+		var sendErr *mail.SendError
+		if err := client.Send(msg); errors.As(err, &sendErr) && sendErr.Reason == mail.ErrConnCheck {
+			return failFrom(i, fmt.Errorf("mailpit: failed to send email: %w", err))
+		}
+		if msg.HasSendError() {
+			errs[i] = fmt.Errorf("mailpit: failed to send email: %w", msg.SendError())
+			mp.suppressBounce(ctx, msg)
+		}
+	}
+
+	return errs
+}
+
+// subscribed drops the recipients who unsubscribed from category.
+func (mp Mailpit) subscribed(ctx context.Context, category string, recipients []string) ([]string, error) {
+	keys := make([]string, len(recipients))
+	for i, recipient := range recipients {
+		keys[i] = pgstore.SuppressionKey(recipient)
+	}
+
+	emails, err := mp.store.GetUnsubscribedEmails(ctx, pgstore.GetUnsubscribedEmailsParams{Category: category, Emails: keys})
+	if err != nil || len(emails) == 0 {
+		return recipients, err
+	}
+
+	unsubscribed := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		unsubscribed[email] = true
+	}
+
+	kept := make([]string, 0, len(recipients))
+	for i, recipient := range recipients {
+		if !unsubscribed[keys[i]] {
+			kept = append(kept, recipient)
+		}
+	}
+
+	logctx.From(ctx, mp.logger).Debug("unsubscribed recipients left out", zap.String("category", category), zap.Int("left_out", len(recipients)-len(kept)))
+	return kept, nil
+}
+
+// setUnsubscribe adds the List-Unsubscribe header opting recipient out of
+// category, and returns the link for the body of the message.
+func (mp Mailpit) setUnsubscribe(msg *mail.Msg, recipient, category string) string {
+	link := mp.settings.Load().PublicURL + "/unsubscribe?token=" + url.QueryEscape(mp.unsubscribe.Token(pgstore.SuppressionKey(recipient), category))
+	msg.SetGenHeader(mail.HeaderListUnsubscribe, "<"+link+">")
+	return link
+}
+
+// suppressed tells which of msgs go to a suppressed address, logging and
+// counting them.
+func (mp Mailpit) suppressed(ctx context.Context, msgs []*mail.Msg) (map[int]bool, error) {
+	keys := make([]string, len(msgs))
+	for i, msg := range msgs {
+		keys[i] = recipientKey(msg)
+	}
+
+	emails, err := mp.store.GetSuppressedEmails(ctx, keys)
+	if err != nil || len(emails) == 0 {
+		return nil, err
+	}
+
+	listed := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		listed[email] = true
+	}
+
+	suppressed := make(map[int]bool, len(emails))
+	for i, key := range keys {
+		if listed[key] {
+			suppressed[i] = true
+		}
+	}
+
+	suppressedSends.Add(int64(len(suppressed)))
+	logctx.From(ctx, mp.logger).Info("emails to suppressed addresses skipped", zap.Int("skipped", len(suppressed)), zap.Int("messages", len(msgs)))
+	return suppressed, nil
+}
+
+// suppressBounce puts the recipient of msg on the suppression list when the
+// server refused it for good. A failure to do so only costs a later bounce.
+func (mp Mailpit) suppressBounce(ctx context.Context, msg *mail.Msg) {
+	var sendErr *mail.SendError
+	if !errors.As(msg.SendError(), &sendErr) || sendErr.IsTemp() || sendErr.Reason != mail.ErrSMTPRcptTo {
+		return
+	}
+
+	_, err := mp.store.SuppressEmail(ctx, pgstore.SuppressEmailParams{
+		Email:  recipientKey(msg),
+		Reason: pgstore.SuppressionBounce,
+		Note:   pgtype.Text{String: sendErr.Error(), Valid: true},
+	})
+	if err != nil {
+		logctx.From(ctx, mp.logger).Warn("failed to suppress bounced address", zap.Error(err))
+	}
+}
+
+// recipientKey is the suppression key of the single recipient of msg.
+func recipientKey(msg *mail.Msg) string {
+	to := msg.GetTo()
+	if len(to) == 0 {
+		return ""
+	}
+	return pgstore.SuppressionKey(to[0].Address)
+}
+
+// newMsg starts a message to the given address, with the configured sender,
+// subject prefix and importance.
+func (mp Mailpit) newMsg(to, subject string) (*mail.Msg, error) {
+	return mp.newMsgWithImportance(to, subject, mp.settings.Load().Importance)
+}
+
+// newMsgWithImportance is newMsg for messages with an importance of their
+// own.
+func (mp Mailpit) newMsgWithImportance(to, subject string, importance mail.Importance) (*mail.Msg, error) {
+	settings := mp.settings.Load()
 	msg := mail.NewMsg()
-	if err := msg.From("mailpit@teste.com"); err != nil {
-		return fmt.Errorf("mailpit: failed to From in email SendConfirmTripEmailToTripOwner: %w", err)
+	if err := msg.FromFormat(settings.FromName, settings.FromAddress); err != nil {
+		return nil, fmt.Errorf("invalid From: %w", err)
 	}
 
-	if err := msg.To(trip.OwnerEmail); err != nil {
-		return fmt.Errorf("mailpit: failed to To in email SendConfirmTripEmailToTripOwner: %w", err)
+	if err := msg.To(to); err != nil {
+		return nil, fmt.Errorf("invalid To: %w", err)
 	}
 
-	msg.Subject("Confirme sua viagem")
-	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
-		Olá, %s!
-		
-		A sua viagem para %s que começa no dia %s precisa ser confirmada.
-		clique no botão abaixo para confirmar.`,
-		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
-	))
+	msg.Subject(settings.SubjectPrefix + subject)
+	msg.SetImportance(importance)
+	return msg, nil
+}
+
+// setReplyTo points replies at the organizer, the trip reply_to or else the
+// owner. From stays the configured sender so the message still passes the
+// domain checks of the receiving server.
+func setReplyTo(msg *mail.Msg, trip pgstore.Trip) error {
+	replyTo := trip.OwnerEmail
+	if trip.ReplyTo.Valid {
+		replyTo = trip.ReplyTo.String
+	}
 
-	client, err := mail.NewClient("localhost", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err := msg.ReplyTo(replyTo); err != nil {
+		return fmt.Errorf("invalid Reply-To: %w", err)
+	}
+	return nil
+}
+
+// Probe connects to the mail server and checks the session with a NOOP,
+// without sending anything.
+func (mp Mailpit) Probe(ctx context.Context) error {
+	client, err := mp.newClient()
 	if err != nil {
-		return fmt.Errorf("mailpit: failed to create email client SendConfirmTripEmailToTripOwner: %w", err)
+		return fmt.Errorf("mailpit: failed to create email client Probe: %w", err)
+	}
+
+	if err := client.DialWithContext(ctx); err != nil {
+		return fmt.Errorf("mailpit: failed to dial Probe: %w", err)
 	}
+	defer client.Close()
 
-	if err := client.DialAndSend(msg); err != nil {
-		return fmt.Errorf("mailpit: failed to send email client SendConfirmTripEmailToTripOwner: %w", err)
+	// Reset sends the NOOP before its RSET, an unresponsive session fails it.
+	if err := client.Reset(); err != nil {
+		return fmt.Errorf("mailpit: failed to check session Probe: %w", err)
 	}
 
 	return nil
 }
+
+func (mp Mailpit) newClient() (*mail.Client, error) {
+	settings := mp.settings.Load()
+	return mail.NewClient(settings.Host, mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(settings.Port))
+}
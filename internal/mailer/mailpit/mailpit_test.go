@@ -0,0 +1,300 @@
+package mailpit
+
+import (
+	"bufio"
+	"context"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+	"journey/internal/unsubscribe"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// smtpServer accepts every message sent to it, just enough SMTP for the
+// mailer to deliver.
+type smtpServer struct {
+	listener net.Listener
+
+	mu   sync.Mutex
+	msgs []*netmail.Message
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpServer{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch verb := strings.ToUpper(strings.Fields(line + " ")[0]); verb {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			if msg, err := netmail.ReadMessage(strings.NewReader(data.String())); err == nil {
+				s.mu.Lock()
+				s.msgs = append(s.msgs, msg)
+				s.mu.Unlock()
+			}
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *smtpServer) received() []*netmail.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*netmail.Message(nil), s.msgs...)
+}
+
+// memStore holds the rows the mailer reads, and suppresses nothing.
+type memStore struct {
+	trips        map[uuid.UUID]pgstore.Trip
+	participants map[uuid.UUID]pgstore.Participant
+	activities   map[uuid.UUID]pgstore.Activity
+}
+
+func (s memStore) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	if trip, ok := s.trips[id]; ok {
+		return trip, nil
+	}
+	return pgstore.Trip{}, pgx.ErrNoRows
+}
+
+func (s memStore) GetParticipant(_ context.Context, id uuid.UUID) (pgstore.Participant, error) {
+	if participant, ok := s.participants[id]; ok {
+		return participant, nil
+	}
+	return pgstore.Participant{}, pgx.ErrNoRows
+}
+
+func (s memStore) GetActivity(_ context.Context, id uuid.UUID) (pgstore.Activity, error) {
+	if activity, ok := s.activities[id]; ok {
+		return activity, nil
+	}
+	return pgstore.Activity{}, pgx.ErrNoRows
+}
+
+func (s memStore) GetTripParticipantStats(context.Context, uuid.UUID) (pgstore.GetTripParticipantStatsRow, error) {
+	return pgstore.GetTripParticipantStatsRow{Invited: 2, Confirmed: 1, Headcount: 2}, nil
+}
+
+func (s memStore) GetSuppressedEmails(context.Context, []string) ([]string, error) {
+	return nil, nil
+}
+
+func (s memStore) SuppressEmail(context.Context, pgstore.SuppressEmailParams) (pgstore.EmailSuppression, error) {
+	return pgstore.EmailSuppression{}, nil
+}
+
+func (s memStore) GetUnsubscribedEmails(context.Context, pgstore.GetUnsubscribedEmailsParams) ([]string, error) {
+	return nil, nil
+}
+
+func newTestMailpit(store store, settings Settings) Mailpit {
+	mp := Mailpit{store, zap.NewNop(), new(atomic.Pointer[Settings]), new(rateLimiter), unsubscribe.NewSigner("test")}
+	mp.SetSettings(settings)
+	return mp
+}
+
+func TestMessageHeaders(t *testing.T) {
+	startsAt := time.Date(2030, 7, 10, 0, 0, 0, 0, time.UTC)
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Lisboa",
+		OwnerEmail:  "ana@example.com",
+		OwnerName:   "Ana",
+		StartsAt:    pgtype.Timestamptz{Time: startsAt, Valid: true},
+		EndsAt:      pgtype.Timestamptz{Time: startsAt.AddDate(0, 0, 5), Valid: true},
+		Timezone:    "UTC",
+	}
+	participant := pgstore.Participant{ID: uuid.New(), TripID: trip.ID, Email: "bia@example.com", IsConfirmed: true}
+	activity := pgstore.Activity{
+		ID:       uuid.New(),
+		TripID:   trip.ID,
+		Title:    "Museu",
+		OccursAt: pgtype.Timestamptz{Time: startsAt.Add(10 * time.Hour), Valid: true},
+	}
+	store := memStore{
+		trips:        map[uuid.UUID]pgstore.Trip{trip.ID: trip},
+		participants: map[uuid.UUID]pgstore.Participant{participant.ID: participant},
+		activities:   map[uuid.UUID]pgstore.Activity{activity.ID: activity},
+	}
+	ownerEmail := pgstore.OwnerEmailPayload{To: "new@example.com", NewEmail: "new@example.com", Token: "token"}
+
+	tests := []struct {
+		name    string
+		send    func(context.Context, Mailpit) error
+		to      string
+		subject string
+	}{
+		{
+			name:    "trip confirmation",
+			send:    func(ctx context.Context, mp Mailpit) error { return mp.SendConfirmTripEmailToTripOwner(ctx, trip.ID) },
+			to:      trip.OwnerEmail,
+			subject: "Confirme sua viagem",
+		},
+		{
+			name: "participant invites",
+			send: func(ctx context.Context, mp Mailpit) error {
+				return mp.SendParticipantInvites(ctx, []uuid.UUID{participant.ID})[0]
+			},
+			to:      participant.Email,
+			subject: "Confirme sua presença na viagem",
+		},
+		{
+			name: "activity reminder",
+			send: func(ctx context.Context, mp Mailpit) error {
+				return mp.SendActivityReminder(ctx, activity.ID, pgtype.UUID{Bytes: participant.ID, Valid: true})
+			},
+			to:      participant.Email,
+			subject: "Lembrete: Museu",
+		},
+		{
+			name:    "rsvp headcount",
+			send:    func(ctx context.Context, mp Mailpit) error { return mp.SendRSVPHeadcount(ctx, trip.ID) },
+			to:      trip.OwnerEmail,
+			subject: "Lista final: Lisboa",
+		},
+		{
+			name: "trip updated",
+			send: func(ctx context.Context, mp Mailpit) error {
+				return mp.SendTripUpdated(ctx, participant.ID, []pgstore.TripChange{{Field: "destination", Old: "Porto", New: "Lisboa"}})
+			},
+			to:      participant.Email,
+			subject: "Sua viagem mudou: Lisboa",
+		},
+		{
+			name:    "owner e-mail verify",
+			send:    func(ctx context.Context, mp Mailpit) error { return mp.SendOwnerEmailVerify(ctx, trip.ID, ownerEmail) },
+			to:      ownerEmail.To,
+			subject: "Confirme o novo e-mail da viagem",
+		},
+		{
+			name:    "owner e-mail change",
+			send:    func(ctx context.Context, mp Mailpit) error { return mp.SendOwnerEmailChange(ctx, trip.ID, ownerEmail) },
+			to:      ownerEmail.To,
+			subject: "Pedido de troca do e-mail da viagem",
+		},
+		{
+			name:    "test e-mail",
+			send:    func(ctx context.Context, mp Mailpit) error { return mp.SendTestEmail(ctx, "ops@example.com") },
+			to:      "ops@example.com",
+			subject: "Journey: e-mail de teste",
+		},
+	}
+
+	settings := []struct {
+		name   string
+		from   string
+		prefix string
+	}{
+		{"no prefix", "Journey", ""},
+		{"staging", "Journey Staging", "[staging] "},
+	}
+
+	var decoder mime.WordDecoder
+	for _, s := range settings {
+		for _, tt := range tests {
+			t.Run(s.name+"/"+tt.name, func(t *testing.T) {
+				server := newSMTPServer(t)
+				mp := newTestMailpit(store, Settings{
+					Host:          "127.0.0.1",
+					Port:          server.port(),
+					FromAddress:   "journey@example.com",
+					FromName:      s.from,
+					SubjectPrefix: s.prefix,
+				})
+
+				if err := tt.send(context.Background(), mp); err != nil {
+					t.Fatalf("send: %v", err)
+				}
+
+				msgs := server.received()
+				if len(msgs) != 1 {
+					t.Fatalf("server got %d messages, want 1", len(msgs))
+				}
+				header := msgs[0].Header
+
+				from, err := netmail.ParseAddress(header.Get("From"))
+				if err != nil {
+					t.Fatalf("From %q: %v", header.Get("From"), err)
+				}
+				if from.Name != s.from || from.Address != "journey@example.com" {
+					t.Errorf("From %q <%s>, want %q <journey@example.com>", from.Name, from.Address, s.from)
+				}
+
+				to, err := netmail.ParseAddress(header.Get("To"))
+				if err != nil || to.Address != tt.to {
+					t.Errorf("To %q, want %s", header.Get("To"), tt.to)
+				}
+
+				subject, err := decoder.DecodeHeader(header.Get("Subject"))
+				if err != nil {
+					t.Fatalf("Subject %q: %v", header.Get("Subject"), err)
+				}
+				if want := s.prefix + tt.subject; subject != want {
+					t.Errorf("Subject %q, want %q", subject, want)
+				}
+			})
+		}
+	}
+}
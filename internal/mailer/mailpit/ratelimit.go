@@ -0,0 +1,73 @@
+package mailpit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every send of the mailer, so a
+// large batch can't exceed the provider limits. Bursts are capped at one
+// second worth of sends.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// setRate changes the allowed sends per second, zero or less disables the
+// limit.
+func (l *rateLimiter) setRate(rate float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = rate
+	l.burst = math.Max(1, math.Ceil(rate))
+	l.tokens = math.Min(l.tokens, l.burst)
+	if l.last.IsZero() {
+		l.tokens = l.burst
+	}
+	l.last = time.Now()
+}
+
+// wait blocks until a send is allowed or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay, ok := l.reserve()
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token when one is available, otherwise it returns how long
+// until the next one.
+func (l *rateLimiter) reserve() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0, true
+	}
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second)), false
+}
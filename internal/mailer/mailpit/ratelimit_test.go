@@ -0,0 +1,77 @@
+package mailpit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+		delay time.Duration
+	}{
+		{"disabled", 0, 100, 0},
+		{"negative disables", -1, 100, 0},
+		{"below one per second", 0.5, 1, 2 * time.Second},
+		{"one per second", 1, 1, time.Second},
+		{"ten per second", 10, 10, 100 * time.Millisecond},
+		{"fractional rate rounds the burst up", 2.5, 3, 400 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l rateLimiter
+			l.setRate(tt.rate)
+
+			for i := range tt.burst {
+				if _, ok := l.reserve(); !ok {
+					t.Fatalf("send %d of the burst of %d refused", i+1, tt.burst)
+				}
+			}
+			if tt.rate <= 0 {
+				return
+			}
+
+			delay, ok := l.reserve()
+			if ok {
+				t.Fatalf("send past the burst of %d allowed", tt.burst)
+			}
+			// A little time passes between the reserves, the wait only
+			// gets shorter.
+			if delay > tt.delay || delay < tt.delay*9/10 {
+				t.Errorf("delay %s, want about %s", delay, tt.delay)
+			}
+		})
+	}
+}
+
+func TestRateLimiterSetRateKeepsTokens(t *testing.T) {
+	var l rateLimiter
+	l.setRate(10)
+	for range 10 {
+		l.reserve()
+	}
+
+	// Raising the limit doesn't hand out a fresh burst.
+	l.setRate(100)
+	if _, ok := l.reserve(); ok {
+		t.Error("send allowed right after raising the rate of an empty bucket")
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	var l rateLimiter
+	l.setRate(1)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("first wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait on an empty bucket: %v, want the context deadline", err)
+	}
+}
@@ -0,0 +1,184 @@
+package mailpit
+
+import (
+	"embed"
+	"errors"
+	"html/template"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/url"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+//go:embed templates/*.html templates/*.txt
+var templatesFS embed.FS
+
+var templates = template.Must(template.ParseFS(templatesFS, "templates/*.html"))
+
+// textTemplates are the plain-text alternatives, only some e-mails have one.
+var textTemplates = texttemplate.Must(texttemplate.ParseFS(templatesFS, "templates/*.txt"))
+
+const (
+	templateConfirmTripOwner   = "confirm_trip_owner"
+	templateConfirmParticipant = "confirm_participant"
+	templateActivityReminder   = "activity_reminder"
+	templateRSVPHeadcount      = "rsvp_headcount"
+	templateTripUpdated        = "trip_updated"
+	templateTestEmail          = "test_email"
+	templateOwnerEmailVerify   = "owner_email_verify"
+	templateOwnerEmailChange   = "owner_email_change"
+)
+
+// templateData is what every e-mail template renders from.
+type templateData struct {
+	OwnerName   string
+	Destination string
+	StartsAt    string
+	Activity    string
+	ActivityAt  string
+	// InviteMessage is the owner note, html/template escapes it like any
+	// other field.
+	InviteMessage string
+	// Invited, Confirmed and Headcount are the participant counts of the
+	// RSVP headcount e-mail, Headcount includes plus ones.
+	Invited   int64
+	Confirmed int64
+	Headcount int64
+	// Changes are the trip fields listed in the trip updated e-mail.
+	Changes []templateChange
+	// NewEmail is the owner address asked for in the owner e-mail change
+	// e-mails, VerifyPath the link that makes it the owner one.
+	NewEmail   string
+	VerifyPath string
+	// UnsubscribeURL opts the recipient out of the category of the
+	// e-mail, only the optional e-mails have one.
+	UnsubscribeURL string
+}
+
+type templateChange struct {
+	Label string
+	Old   string
+	New   string
+}
+
+// changeLabels name the trip fields in the trip updated e-mail.
+var changeLabels = map[string]string{
+	"destination": "Destino",
+	"starts_at":   "Início",
+	"ends_at":     "Fim",
+}
+
+func newTemplateChanges(changes []pgstore.TripChange) []templateChange {
+	lines := make([]templateChange, 0, len(changes))
+	for _, change := range changes {
+		label, ok := changeLabels[change.Field]
+		if !ok {
+			label = change.Field
+		}
+		lines = append(lines, templateChange{label, formatChangeValue(change.Old), formatChangeValue(change.New)})
+	}
+	return lines
+}
+
+// formatChangeValue shows dates like the other e-mails do, keeping the time
+// only when it isn't midnight. Other values are shown as they are.
+func formatChangeValue(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	if t.Equal(t.Truncate(24 * time.Hour)) {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+func newTemplateData(trip pgstore.Trip) templateData {
+	data := templateData{
+		OwnerName:     trip.OwnerName,
+		Destination:   trip.Destination,
+		InviteMessage: trip.InviteMessage.String,
+	}
+	// Trips still polling their dates have none to show yet.
+	if trip.StartsAt.Valid {
+		data.StartsAt = trip.StartsAt.Time.UTC().Format(time.DateOnly)
+	}
+	return data
+}
+
+// ownerEmailVerifyPath is the API path that verifies a new owner e-mail.
+func ownerEmailVerifyPath(tripID uuid.UUID, token string) string {
+	return "/trips/" + tripID.String() + "/owner-email/verify?token=" + url.QueryEscape(token)
+}
+
+func lookupTemplate(name string) *template.Template {
+	return templates.Lookup(name + ".html")
+}
+
+// lookupTextTemplate returns the plain-text alternative of the named
+// template, or nil when it has none.
+func lookupTextTemplate(name string) *texttemplate.Template {
+	return textTemplates.Lookup(name + ".txt")
+}
+
+// PreviewHandler renders the template named in the URL as HTML, using the
+// trip given by the tripId query parameter or sample data without one. It is
+// meant for development only and must not be mounted otherwise.
+func (mp Mailpit) PreviewHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tpl := lookupTemplate(chi.URLParam(r, "template"))
+		if tpl == nil {
+			http.Error(w, "template not found", http.StatusNotFound)
+			return
+		}
+
+		trip := pgstore.Trip{
+			OwnerName:   "Maria",
+			Destination: "Florianópolis",
+			StartsAt:    pgtype.Timestamptz{Time: time.Now().AddDate(0, 1, 0), Valid: true},
+		}
+
+		if raw := r.URL.Query().Get("tripId"); raw != "" {
+			tripID, err := uuid.Parse(raw)
+			if err != nil {
+				http.Error(w, "uuid invalid", http.StatusBadRequest)
+				return
+			}
+
+			trip, err = mp.store.GetTrip(r.Context(), tripID)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					http.Error(w, "trip not found", http.StatusNotFound)
+					return
+				}
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		data := newTemplateData(trip)
+		data.Activity = "Passeio de barco"
+		data.ActivityAt = trip.StartsAt.Time.UTC().Add(26 * time.Hour).Format(time.DateTime)
+		if data.InviteMessage == "" {
+			data.InviteMessage = "Vamos comemorar juntos!"
+		}
+		data.Invited, data.Confirmed, data.Headcount = 8, 5, 7
+		data.NewEmail = "maria@example.com"
+		data.VerifyPath = ownerEmailVerifyPath(trip.ID, "token")
+		data.UnsubscribeURL = mp.settings.Load().PublicURL + "/unsubscribe?token=token"
+		data.Changes = newTemplateChanges([]pgstore.TripChange{
+			{Field: "starts_at", Old: trip.StartsAt.Time.UTC().Format(time.RFC3339), New: trip.StartsAt.Time.UTC().AddDate(0, 0, 2).Format(time.RFC3339)},
+		})
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := tpl.Execute(w, data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
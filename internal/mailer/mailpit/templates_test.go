@@ -0,0 +1,56 @@
+package mailpit
+
+import (
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestNewTemplateDataStartsAt(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		startsAt pgtype.Timestamptz
+		want     string
+	}{
+		{"utc", pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 9, 0, 0, 0, time.UTC), Valid: true}, "2030-07-10"},
+		// Late evening in São Paulo is already the next day in UTC.
+		{"read in another zone", pgtype.Timestamptz{Time: time.Date(2030, 7, 10, 22, 30, 0, 0, saoPaulo), Valid: true}, "2030-07-11"},
+		{"polling", pgtype.Timestamptz{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := newTemplateData(pgstore.Trip{StartsAt: tt.startsAt})
+			if data.StartsAt != tt.want {
+				t.Errorf("StartsAt %q, want %q", data.StartsAt, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatChangeValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"Lisboa", "Lisboa"},
+		{"2030-07-10T00:00:00Z", "2030-07-10"},
+		{"2030-07-10T14:30:00Z", "2030-07-10 14:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := formatChangeValue(tt.value); got != tt.want {
+				t.Errorf("formatChangeValue(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
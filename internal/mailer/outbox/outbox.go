@@ -0,0 +1,280 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"journey/internal/logctx"
+	"journey/internal/pgstore"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+)
+
+const (
+	batchSize = 20
+	// lease keeps a claimed row away from other workers while it is sent.
+	lease       = time.Minute
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// deadLetterBacklog is refreshed on every poll.
+var deadLetterBacklog = expvar.NewInt("email_dead_letter_backlog")
+
+type store interface {
+	ClaimDueEmails(context.Context, pgstore.ClaimDueEmailsParams) ([]pgstore.EmailOutbox, error)
+	DeferEmail(context.Context, pgstore.DeferEmailParams) (int64, error)
+	MarkEmailSent(context.Context, uuid.UUID) error
+	MarkEmailRetry(context.Context, pgstore.MarkEmailRetryParams) error
+	MarkEmailDeadLetter(context.Context, pgstore.MarkEmailDeadLetterParams) error
+	CountDeadLetterEmails(context.Context) (int64, error)
+}
+
+type mailer interface {
+	SendConfirmTripEmailToTripOwner(context.Context, uuid.UUID) error
+	SendActivityReminder(context.Context, uuid.UUID, pgtype.UUID) error
+	SendParticipantInvites(context.Context, []uuid.UUID) []error
+	SendRSVPHeadcount(context.Context, uuid.UUID) error
+	SendTripUpdated(context.Context, uuid.UUID, []pgstore.TripChange) error
+	SendOwnerEmailVerify(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error
+	SendOwnerEmailChange(context.Context, uuid.UUID, pgstore.OwnerEmailPayload) error
+	SendTestEmail(context.Context, string) error
+}
+
+// Worker delivers the queued e-mails, retrying failures with exponential
+// backoff. Rows that fail permanently or run out of attempts are moved to the
+// dead letter state and never picked up again until requeued.
+type Worker struct {
+	store       store
+	mailer      mailer
+	logger      *zap.Logger
+	maxAttempts int32
+	// confirmDelay holds trip confirmations back after they are queued, so
+	// read replicas can catch up with the new trip before the mailer reads it.
+	confirmDelay time.Duration
+	// workers is how many e-mails of a batch are sent at once. The mailer
+	// rate limit is shared, so more workers only help while it isn't hit.
+	workers int
+}
+
+func NewWorker(store store, mailer mailer, logger *zap.Logger, maxAttempts int, confirmDelay time.Duration, workers int) *Worker {
+	return &Worker{store, mailer, logger, int32(maxAttempts), confirmDelay, max(workers, 1)}
+}
+
+// Poll delivers a batch of due e-mails, the outbox job runs it.
+func (w *Worker) Poll(ctx context.Context) error {
+	emails, err := w.store.ClaimDueEmails(ctx, pgstore.ClaimDueEmailsParams{
+		LeaseSeconds: lease.Seconds(),
+		BatchSize:    batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("outbox: failed to claim emails: %w", err)
+	}
+
+	// The invites go out together over shared SMTP sessions, the other
+	// e-mails one by one on the workers.
+	var invites, others []pgstore.EmailOutbox
+	for _, email := range emails {
+		if email.Kind == pgstore.EmailKindParticipantInvite && email.ParticipantID.Valid {
+			invites = append(invites, email)
+		} else {
+			others = append(others, email)
+		}
+	}
+
+	queue := make(chan pgstore.EmailOutbox)
+	var wg sync.WaitGroup
+	for range min(w.workers, len(others)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for email := range queue {
+				w.logUpdateError(ctx, email, w.deliver(ctx, email))
+			}
+		}()
+	}
+	if len(invites) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.deliverInvites(ctx, invites)
+		}()
+	}
+	for _, email := range others {
+		queue <- email
+	}
+	close(queue)
+	wg.Wait()
+
+	backlog, err := w.store.CountDeadLetterEmails(ctx)
+	if err != nil {
+		return fmt.Errorf("outbox: failed to count dead letters: %w", err)
+	}
+	deadLetterBacklog.Set(backlog)
+
+	return nil
+}
+
+// deliver sends one e-mail. The mailer gets a context logging with the
+// fields of the e-mail, the request that queued it included.
+func (w *Worker) deliver(ctx context.Context, email pgstore.EmailOutbox) error {
+	logger := logctx.From(ctx, w.logger).With(emailFields(email)...)
+	ctx = logctx.With(ctx, logger)
+
+	if email.Kind == pgstore.EmailKindConfirmTripOwner && w.confirmDelay > 0 {
+		// The database clock decides, rows still inside the delay are pushed
+		// back to when it ends without spending an attempt.
+		deferred, err := w.store.DeferEmail(ctx, pgstore.DeferEmailParams{
+			DelaySeconds: w.confirmDelay.Seconds(),
+			ID:           email.ID,
+		})
+		if err != nil || deferred > 0 {
+			return err
+		}
+	}
+
+	return w.finish(ctx, email, w.send(ctx, email))
+}
+
+// deliverInvites sends the participant invites as a batch, each row then
+// goes on with the outcome of its own invite.
+func (w *Worker) deliverInvites(ctx context.Context, invites []pgstore.EmailOutbox) {
+	ids := make([]uuid.UUID, len(invites))
+	for i, email := range invites {
+		ids[i] = email.ParticipantID.Bytes
+	}
+
+	errs := w.mailer.SendParticipantInvites(ctx, ids)
+	for i, email := range invites {
+		emailCtx := logctx.With(ctx, logctx.From(ctx, w.logger).With(emailFields(email)...))
+		w.logUpdateError(ctx, email, w.finish(emailCtx, email, errs[i]))
+	}
+}
+
+// finish marks email sent when err is nil. Otherwise it is retried with
+// backoff, or dead lettered when err is permanent or the attempts ran out.
+func (w *Worker) finish(ctx context.Context, email pgstore.EmailOutbox, err error) error {
+	logger := logctx.From(ctx, w.logger)
+	if err == nil {
+		logger.Debug("email sent")
+		return w.store.MarkEmailSent(ctx, email.ID)
+	}
+
+	lastError := pgtype.Text{String: err.Error(), Valid: true}
+	attempts := email.Attempts + 1
+	if isPermanent(err) || attempts >= w.maxAttempts {
+		logger.Warn("email moved to dead letter", zap.Error(err), zap.Int32("attempts", attempts))
+		return w.store.MarkEmailDeadLetter(ctx, pgstore.MarkEmailDeadLetterParams{LastError: lastError, ID: email.ID})
+	}
+
+	backoff := min(baseBackoff<<min(attempts-1, 10), maxBackoff)
+	return w.store.MarkEmailRetry(ctx, pgstore.MarkEmailRetryParams{
+		LastError:      lastError,
+		BackoffSeconds: backoff.Seconds(),
+		ID:             email.ID,
+	})
+}
+
+// send hands email to the mailer method of its kind.
+func (w *Worker) send(ctx context.Context, email pgstore.EmailOutbox) error {
+	if email.Kind == pgstore.EmailKindTestEmail {
+		var payload pgstore.TestEmailPayload
+		if err := json.Unmarshal(email.Payload, &payload); err != nil {
+			return permanent(fmt.Errorf("outbox: invalid test e-mail payload: %w", err))
+		}
+		return w.mailer.SendTestEmail(ctx, payload.To)
+	}
+
+	// Every other kind belongs to a trip.
+	if !email.TripID.Valid {
+		return permanent(fmt.Errorf("outbox: %s e-mail without trip", email.Kind))
+	}
+	tripID := uuid.UUID(email.TripID.Bytes)
+
+	switch email.Kind {
+	case pgstore.EmailKindConfirmTripOwner:
+		return w.mailer.SendConfirmTripEmailToTripOwner(ctx, tripID)
+	case pgstore.EmailKindActivityReminder:
+		if !email.ActivityID.Valid {
+			return permanent(errors.New("outbox: activity reminder without activity"))
+		}
+		return w.mailer.SendActivityReminder(ctx, email.ActivityID.Bytes, email.ParticipantID)
+	case pgstore.EmailKindParticipantInvite:
+		// Poll sends the invites together, only the ones without a
+		// participant end up here.
+		return permanent(errors.New("outbox: participant invite without participant"))
+	case pgstore.EmailKindRSVPHeadcount:
+		return w.mailer.SendRSVPHeadcount(ctx, tripID)
+	case pgstore.EmailKindTripUpdated:
+		if !email.ParticipantID.Valid {
+			return permanent(errors.New("outbox: trip update without participant"))
+		}
+		var changes []pgstore.TripChange
+		if err := json.Unmarshal(email.Payload, &changes); err != nil {
+			return permanent(fmt.Errorf("outbox: invalid trip update payload: %w", err))
+		}
+		return w.mailer.SendTripUpdated(ctx, email.ParticipantID.Bytes, changes)
+	case pgstore.EmailKindOwnerEmailVerify, pgstore.EmailKindOwnerEmailChange:
+		var payload pgstore.OwnerEmailPayload
+		if err := json.Unmarshal(email.Payload, &payload); err != nil {
+			return permanent(fmt.Errorf("outbox: invalid owner e-mail payload: %w", err))
+		}
+		if email.Kind == pgstore.EmailKindOwnerEmailVerify {
+			return w.mailer.SendOwnerEmailVerify(ctx, tripID, payload)
+		}
+		return w.mailer.SendOwnerEmailChange(ctx, tripID, payload)
+	}
+	return permanent(fmt.Errorf("outbox: unknown email kind %q", email.Kind))
+}
+
+func (w *Worker) logUpdateError(ctx context.Context, email pgstore.EmailOutbox, err error) {
+	if err != nil {
+		logctx.From(ctx, w.logger).Error("failed to update email outbox", append(emailFields(email), zap.Error(err))...)
+	}
+}
+
+// emailFields identifies an e-mail in the logs, along with its trip and the
+// request or job run that queued it, so the send can be traced back to the
+// user action.
+func emailFields(email pgstore.EmailOutbox) []zap.Field {
+	return []zap.Field{
+		zap.String("email_id", email.ID.String()),
+		zap.String("kind", email.Kind),
+		zap.String("trip_id", tripIDOrEmpty(email.TripID)),
+		zap.String("request_id", email.RequestID.String),
+	}
+}
+
+// tripIDOrEmpty is the trip of an e-mail for the logs, empty for the ones
+// of no trip.
+func tripIDOrEmpty(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
+
+type permanentError struct{ error }
+
+func (e permanentError) Unwrap() error { return e.error }
+
+func permanent(err error) error { return permanentError{err} }
+
+// isPermanent reports whether retrying err is pointless, like a recipient or
+// message the SMTP server rejected outright.
+func isPermanent(err error) bool {
+	if errors.As(err, new(permanentError)) {
+		return true
+	}
+
+	var sendErr *mail.SendError
+	return errors.As(err, &sendErr) && !sendErr.IsTemp() &&
+		(sendErr.Reason == mail.ErrSMTPRcptTo || sendErr.Reason == mail.ErrSMTPData || sendErr.Reason == mail.ErrSMTPMailFrom)
+}
@@ -0,0 +1,244 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"journey/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+)
+
+// outcome is what the worker did with a row.
+type outcome struct {
+	state   string
+	backoff time.Duration
+}
+
+// memStore hands out its rows once and records their outcome.
+type memStore struct {
+	mu       sync.Mutex
+	emails   []pgstore.EmailOutbox
+	outcomes map[uuid.UUID]outcome
+}
+
+func newMemStore(emails ...pgstore.EmailOutbox) *memStore {
+	return &memStore{emails: emails, outcomes: make(map[uuid.UUID]outcome)}
+}
+
+func (s *memStore) ClaimDueEmails(context.Context, pgstore.ClaimDueEmailsParams) ([]pgstore.EmailOutbox, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	emails := s.emails
+	s.emails = nil
+	return emails, nil
+}
+
+func (s *memStore) DeferEmail(context.Context, pgstore.DeferEmailParams) (int64, error) {
+	return 0, nil
+}
+
+func (s *memStore) MarkEmailSent(_ context.Context, id uuid.UUID) error {
+	return s.record(id, outcome{state: "sent"})
+}
+
+func (s *memStore) MarkEmailRetry(_ context.Context, arg pgstore.MarkEmailRetryParams) error {
+	return s.record(arg.ID, outcome{state: "retry", backoff: time.Duration(arg.BackoffSeconds * float64(time.Second))})
+}
+
+func (s *memStore) MarkEmailDeadLetter(_ context.Context, arg pgstore.MarkEmailDeadLetterParams) error {
+	return s.record(arg.ID, outcome{state: "dead_letter"})
+}
+
+func (s *memStore) CountDeadLetterEmails(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for _, o := range s.outcomes {
+		if o.state == "dead_letter" {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *memStore) record(id uuid.UUID, o outcome) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.outcomes[id]; ok {
+		return fmt.Errorf("email %s finished twice", id)
+	}
+	s.outcomes[id] = o
+	return nil
+}
+
+// fakeMailer fails the e-mails of the participants or trips in errs.
+type fakeMailer struct {
+	errs map[uuid.UUID]error
+}
+
+func (m fakeMailer) SendConfirmTripEmailToTripOwner(_ context.Context, tripID uuid.UUID) error {
+	return m.errs[tripID]
+}
+
+func (m fakeMailer) SendActivityReminder(_ context.Context, activityID uuid.UUID, _ pgtype.UUID) error {
+	return m.errs[activityID]
+}
+
+func (m fakeMailer) SendParticipantInvites(_ context.Context, ids []uuid.UUID) []error {
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		errs[i] = m.errs[id]
+	}
+	return errs
+}
+
+func (m fakeMailer) SendRSVPHeadcount(_ context.Context, tripID uuid.UUID) error {
+	return m.errs[tripID]
+}
+
+func (m fakeMailer) SendTripUpdated(_ context.Context, participantID uuid.UUID, _ []pgstore.TripChange) error {
+	return m.errs[participantID]
+}
+
+func (m fakeMailer) SendOwnerEmailVerify(_ context.Context, tripID uuid.UUID, _ pgstore.OwnerEmailPayload) error {
+	return m.errs[tripID]
+}
+
+func (m fakeMailer) SendOwnerEmailChange(_ context.Context, tripID uuid.UUID, _ pgstore.OwnerEmailPayload) error {
+	return m.errs[tripID]
+}
+
+func (m fakeMailer) SendTestEmail(context.Context, string) error { return nil }
+
+func TestPoll(t *testing.T) {
+	errTimeout := errors.New("smtp: i/o timeout")
+	errRejected := &mail.SendError{Reason: mail.ErrSMTPRcptTo}
+
+	valid := func(id uuid.UUID) pgtype.UUID { return pgtype.UUID{Bytes: id, Valid: true} }
+
+	tests := []struct {
+		name     string
+		kind     string
+		attempts int32
+		noTrip   bool
+		noTarget bool
+		err      error
+		want     outcome
+	}{
+		{name: "sent", kind: pgstore.EmailKindRSVPHeadcount, want: outcome{state: "sent"}},
+		{name: "first retry", kind: pgstore.EmailKindRSVPHeadcount, err: errTimeout, want: outcome{"retry", 30 * time.Second}},
+		{name: "third retry", kind: pgstore.EmailKindRSVPHeadcount, attempts: 2, err: errTimeout, want: outcome{"retry", 2 * time.Minute}},
+		{name: "backoff capped", kind: pgstore.EmailKindRSVPHeadcount, attempts: 8, err: errTimeout, want: outcome{"retry", time.Hour}},
+		{name: "attempts ran out", kind: pgstore.EmailKindRSVPHeadcount, attempts: 9, err: errTimeout, want: outcome{state: "dead_letter"}},
+		{name: "rejected recipient", kind: pgstore.EmailKindRSVPHeadcount, err: errRejected, want: outcome{state: "dead_letter"}},
+		{name: "without trip", kind: pgstore.EmailKindRSVPHeadcount, noTrip: true, want: outcome{state: "dead_letter"}},
+		{name: "unknown kind", kind: "postcard", want: outcome{state: "dead_letter"}},
+		{name: "reminder without activity", kind: pgstore.EmailKindActivityReminder, noTarget: true, want: outcome{state: "dead_letter"}},
+		{name: "invite sent", kind: pgstore.EmailKindParticipantInvite, want: outcome{state: "sent"}},
+		{name: "invite retry", kind: pgstore.EmailKindParticipantInvite, attempts: 1, err: errTimeout, want: outcome{"retry", time.Minute}},
+		{name: "invite without participant", kind: pgstore.EmailKindParticipantInvite, noTarget: true, want: outcome{state: "dead_letter"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tripID, targetID := uuid.New(), uuid.New()
+			email := pgstore.EmailOutbox{ID: uuid.New(), Kind: tt.kind, Attempts: tt.attempts}
+			if !tt.noTrip {
+				email.TripID = valid(tripID)
+			}
+			if !tt.noTarget {
+				email.ParticipantID = valid(targetID)
+				email.ActivityID = valid(targetID)
+			}
+
+			store := newMemStore(email)
+			mailer := fakeMailer{errs: map[uuid.UUID]error{tripID: tt.err, targetID: tt.err}}
+			w := NewWorker(store, mailer, zap.NewNop(), 10, 0, 2)
+
+			if err := w.Poll(context.Background()); err != nil {
+				t.Fatalf("poll: %v", err)
+			}
+			if got := store.outcomes[email.ID]; got != tt.want {
+				t.Errorf("outcome %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollBatch(t *testing.T) {
+	errTimeout := errors.New("smtp: i/o timeout")
+	tripID := uuid.New()
+
+	failing := uuid.New()
+	mailer := fakeMailer{errs: map[uuid.UUID]error{failing: errTimeout}}
+
+	var emails []pgstore.EmailOutbox
+	for i := range batchSize {
+		email := pgstore.EmailOutbox{ID: uuid.New(), TripID: pgtype.UUID{Bytes: tripID, Valid: true}}
+		switch {
+		case i == 0:
+			email.Kind = pgstore.EmailKindParticipantInvite
+			email.ParticipantID = pgtype.UUID{Bytes: failing, Valid: true}
+		case i%2 == 0:
+			email.Kind = pgstore.EmailKindParticipantInvite
+			email.ParticipantID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		default:
+			email.Kind = pgstore.EmailKindRSVPHeadcount
+		}
+		emails = append(emails, email)
+	}
+
+	store := newMemStore(emails...)
+	w := NewWorker(store, mailer, zap.NewNop(), 10, 0, 4)
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+
+	if len(store.outcomes) != len(emails) {
+		t.Fatalf("%d of %d e-mails finished", len(store.outcomes), len(emails))
+	}
+	for i, email := range emails {
+		want := "sent"
+		if i == 0 {
+			want = "retry"
+		}
+		if got := store.outcomes[email.ID].state; got != want {
+			t.Errorf("e-mail %d (%s) %s, want %s", i, email.Kind, got, want)
+		}
+	}
+}
+
+func TestIsPermanent(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"timeout", errors.New("smtp: i/o timeout"), false},
+		{"marked permanent", permanent(errors.New("outbox: bad payload")), true},
+		{"wrapped permanent", fmt.Errorf("send: %w", permanent(errors.New("outbox: bad payload"))), true},
+		{"rejected recipient", &mail.SendError{Reason: mail.ErrSMTPRcptTo}, true},
+		{"rejected message", &mail.SendError{Reason: mail.ErrSMTPData}, true},
+		{"rejected sender", &mail.SendError{Reason: mail.ErrSMTPMailFrom}, true},
+		{"connection failed", &mail.SendError{Reason: mail.ErrConnCheck}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPermanent(tt.err); got != tt.want {
+				t.Errorf("isPermanent(%v) = %t, want %t", tt.err, got, tt.want)
+			}
+		})
+	}
+}
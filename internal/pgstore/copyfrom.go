@@ -29,8 +29,10 @@ func (r *iteratorForInviteParticipantsToTrip) Next() bool {
 
 func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
+		r.rows[0].ID,
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].ExpiresAt,
 	}, nil
 }
 
@@ -39,5 +41,5 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"id", "trip_id", "email", "expires_at"}, &iteratorForInviteParticipantsToTrip{rows: arg})
 }
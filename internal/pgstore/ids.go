@@ -0,0 +1,10 @@
+package pgstore
+
+import "github.com/google/uuid"
+
+// NewID returns the ID of a new row. IDs are UUIDv7, so they sort roughly by
+// creation time and new rows land at the end of the primary key index instead
+// of all over it. Existing v4 IDs stay valid, only new rows use v7.
+func NewID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
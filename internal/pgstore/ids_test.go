@@ -0,0 +1,41 @@
+package pgstore
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewIDSortsByCreation(t *testing.T) {
+	ids := make([]uuid.UUID, 0, 1000)
+	for i := range cap(ids) {
+		// A few IDs share a millisecond, the others are spread over several.
+		if i%250 == 0 {
+			time.Sleep(2 * time.Millisecond)
+		}
+		ids = append(ids, NewID())
+	}
+
+	for i, id := range ids {
+		if id.Version() != 7 {
+			t.Fatalf("ID %s is version %d, want 7", id, id.Version())
+		}
+		if i > 0 && bytes.Compare(ids[i-1][:], id[:]) >= 0 {
+			t.Fatalf("ID %d %s sorts before the earlier %s", i, id, ids[i-1])
+		}
+	}
+}
+
+func TestNewIDTime(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	id := NewID()
+	after := time.Now()
+
+	sec, nsec := id.Time().UnixTime()
+	created := time.Unix(sec, nsec)
+	if created.Before(before) || created.After(after) {
+		t.Errorf("ID %s created at %s, want between %s and %s", id, created, before, after)
+	}
+}
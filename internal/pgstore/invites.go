@@ -0,0 +1,24 @@
+package pgstore
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// InviteExpiry is when an invite sent now stops being accepted: ttl from now,
+// or the trip start when that comes first. A zero ttl never expires invites.
+// expires_at has no time zone, it is always written in UTC.
+func InviteExpiry(startsAt pgtype.Timestamptz, ttl time.Duration) pgtype.Timestamp {
+	if ttl <= 0 {
+		return pgtype.Timestamp{}
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+	if startsAt.Valid && startsAt.Time.After(now) && startsAt.Time.Before(expiresAt) {
+		expiresAt = startsAt.Time.UTC()
+	}
+
+	return pgtype.Timestamp{Time: expiresAt, Valid: true}
+}
@@ -0,0 +1,57 @@
+package pgstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestInviteExpiry(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A server running outside UTC must not shift the naive expires_at.
+	local := time.Local
+	time.Local = saoPaulo
+	t.Cleanup(func() { time.Local = local })
+
+	const ttl = 72 * time.Hour
+	now := time.Now()
+	soon := now.Add(24 * time.Hour).In(saoPaulo)
+
+	tests := []struct {
+		name     string
+		startsAt pgtype.Timestamptz
+		ttl      time.Duration
+		want     time.Time
+		valid    bool
+	}{
+		{"no ttl", pgtype.Timestamptz{Time: soon, Valid: true}, 0, time.Time{}, false},
+		{"no start", pgtype.Timestamptz{}, ttl, now.Add(ttl), true},
+		{"start before the ttl", pgtype.Timestamptz{Time: soon, Valid: true}, ttl, soon, true},
+		{"start after the ttl", pgtype.Timestamptz{Time: now.Add(2 * ttl), Valid: true}, ttl, now.Add(ttl), true},
+		{"start passed", pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true}, ttl, now.Add(ttl), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InviteExpiry(tt.startsAt, tt.ttl)
+			if got.Valid != tt.valid {
+				t.Fatalf("valid %t, want %t", got.Valid, tt.valid)
+			}
+			if !tt.valid {
+				return
+			}
+
+			if got.Time.Location() != time.UTC {
+				t.Errorf("expiry in %s, want UTC wall clock time", got.Time.Location())
+			}
+			if diff := got.Time.Sub(tt.want); diff < -time.Second || diff > time.Second {
+				t.Errorf("expiry %s, want %s", got.Time, tt.want.UTC())
+			}
+		})
+	}
+}
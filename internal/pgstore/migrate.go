@@ -0,0 +1,111 @@
+package pgstore
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migrationSeparator splits the up and down halves of a tern migration file.
+const migrationSeparator = "---- create above / drop below ----"
+
+// versionTable matches tern's default so both runners share the same state.
+const versionTable = "public.schema_version"
+
+type migration struct {
+	version int32
+	name    string
+	up      string
+}
+
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to read embedded migrations: %w", err)
+	}
+
+	var migrations []migration
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			continue
+		}
+
+		version, err := strconv.ParseInt(prefix, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: invalid migration name %q: %w", entry.Name(), err)
+		}
+
+		content, err := migrationsFS.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to read migration %q: %w", entry.Name(), err)
+		}
+
+		up, _, _ := strings.Cut(string(content), migrationSeparator)
+		migrations = append(migrations, migration{int32(version), entry.Name(), up})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// Migrate applies every embedded migration newer than the current schema
+// version, each one in its own transaction. It returns the version the schema
+// was at before and after running.
+func Migrate(ctx context.Context, pool *pgxpool.Pool) (from, to int32, err error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if _, err := pool.Exec(ctx, "CREATE TABLE IF NOT EXISTS "+versionTable+" (version int4 NOT NULL)"); err != nil {
+		return 0, 0, fmt.Errorf("pgstore: failed to create version table for Migrate: %w", err)
+	}
+
+	if _, err := pool.Exec(ctx, "INSERT INTO "+versionTable+" (version) SELECT 0 WHERE NOT EXISTS (SELECT 1 FROM "+versionTable+")"); err != nil {
+		return 0, 0, fmt.Errorf("pgstore: failed to init version table for Migrate: %w", err)
+	}
+
+	if err := pool.QueryRow(ctx, "SELECT version FROM "+versionTable).Scan(&from); err != nil {
+		return 0, 0, fmt.Errorf("pgstore: failed to read schema version for Migrate: %w", err)
+	}
+
+	to = from
+	for _, m := range migrations {
+		if m.version <= to {
+			continue
+		}
+
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return from, to, fmt.Errorf("pgstore: failed to begin trx for Migrate: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, m.up); err != nil {
+			_ = tx.Rollback(ctx)
+			return from, to, fmt.Errorf("pgstore: failed to apply migration %s: %w", m.name, err)
+		}
+
+		if _, err := tx.Exec(ctx, "UPDATE "+versionTable+" SET version = $1", m.version); err != nil {
+			_ = tx.Rollback(ctx)
+			return from, to, fmt.Errorf("pgstore: failed to bump schema version for Migrate: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return from, to, fmt.Errorf("pgstore: failed to commit tx for Migrate: %w", err)
+		}
+		to = m.version
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,10 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "is_draft" BOOLEAN NOT NULL DEFAULT FALSE,
+    ALTER COLUMN "starts_at" DROP NOT NULL,
+    ALTER COLUMN "ends_at" DROP NOT NULL;
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "is_draft",
+    ALTER COLUMN "starts_at" SET NOT NULL,
+    ALTER COLUMN "ends_at" SET NOT NULL;
\ No newline at end of file
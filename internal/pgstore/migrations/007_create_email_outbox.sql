@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS email_outbox (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "trip_id" uuid NOT NULL,
+    "kind" VARCHAR(64) NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'pending',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "last_error" TEXT,
+    "next_attempt_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS email_outbox_pending_idx
+    ON email_outbox ("next_attempt_at")
+    WHERE "status" = 'pending';
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_outbox;
\ No newline at end of file
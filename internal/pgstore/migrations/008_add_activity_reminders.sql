@@ -0,0 +1,24 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "remind_before" INTEGER,
+    ADD COLUMN IF NOT EXISTS "remind_participants" BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS "reminder_sent_at" TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS activities_pending_reminder_idx
+    ON activities ("occurs_at")
+    WHERE "remind_before" IS NOT NULL AND "reminder_sent_at" IS NULL;
+
+ALTER TABLE email_outbox
+    ADD COLUMN IF NOT EXISTS "activity_id" uuid REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE;
+---- create above / drop below ----
+
+ALTER TABLE email_outbox
+    DROP COLUMN IF EXISTS "activity_id";
+
+DROP INDEX IF EXISTS activities_pending_reminder_idx;
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "reminder_sent_at",
+    DROP COLUMN IF EXISTS "remind_participants",
+    DROP COLUMN IF EXISTS "remind_before";
\ No newline at end of file
@@ -0,0 +1,12 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "invite_message" VARCHAR(500);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invite_message" VARCHAR(500);
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invite_message";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "invite_message";
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "expires_at" TIMESTAMP;
+
+ALTER TABLE email_outbox
+    ADD COLUMN IF NOT EXISTS "participant_id" uuid REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE;
+---- create above / drop below ----
+
+ALTER TABLE email_outbox
+    DROP COLUMN IF EXISTS "participant_id";
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "expires_at";
\ No newline at end of file
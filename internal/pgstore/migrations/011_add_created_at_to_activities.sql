@@ -0,0 +1,6 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMP NOT NULL DEFAULT now();
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "created_at";
\ No newline at end of file
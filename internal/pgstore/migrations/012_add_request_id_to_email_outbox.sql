@@ -0,0 +1,6 @@
+ALTER TABLE email_outbox
+    ADD COLUMN IF NOT EXISTS "request_id" TEXT;
+---- create above / drop below ----
+
+ALTER TABLE email_outbox
+    DROP COLUMN IF EXISTS "request_id";
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE trips
+    ALTER COLUMN "starts_at" TYPE TIMESTAMPTZ USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at" TYPE TIMESTAMPTZ USING "ends_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMPTZ USING "occurs_at" AT TIME ZONE 'UTC';
+---- create above / drop below ----
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at" TYPE TIMESTAMP USING "occurs_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE trips
+    ALTER COLUMN "ends_at" TYPE TIMESTAMP USING "ends_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "starts_at" TYPE TIMESTAMP USING "starts_at" AT TIME ZONE 'UTC';
\ No newline at end of file
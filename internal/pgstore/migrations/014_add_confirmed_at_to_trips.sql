@@ -0,0 +1,6 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "confirmed_at" TIMESTAMPTZ;
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "confirmed_at";
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "plus_ones" INTEGER NOT NULL DEFAULT 0 CHECK ("plus_ones" >= 0);
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "plus_ones";
\ No newline at end of file
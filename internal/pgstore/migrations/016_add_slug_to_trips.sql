@@ -0,0 +1,23 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "slug" VARCHAR(64);
+
+-- Existing trips get a slug from their destination and ID, new ones get a
+-- random suffix from the application.
+UPDATE trips
+SET "slug" = concat_ws(
+        '-',
+        NULLIF(trim(BOTH '-' FROM left(regexp_replace(lower(unaccent("destination")), '[^a-z0-9]+', '-', 'g'), 40)), ''),
+        left(md5("id"::text), 6)
+    )
+WHERE "slug" IS NULL;
+
+ALTER TABLE trips
+    ALTER COLUMN "slug" SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS trips_slug_idx ON trips ("slug");
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_slug_idx;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "slug";
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "version";
\ No newline at end of file
@@ -0,0 +1,17 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "rsvp_deadline" TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS "rsvp_closed_at" TIMESTAMPTZ,
+    ADD CONSTRAINT trips_rsvp_deadline_check CHECK ("rsvp_deadline" < "starts_at");
+
+-- Only the trips still waiting for their deadline are looked at by the job.
+CREATE INDEX IF NOT EXISTS trips_rsvp_deadline_idx
+    ON trips ("rsvp_deadline")
+    WHERE "rsvp_closed_at" IS NULL;
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_rsvp_deadline_idx;
+
+ALTER TABLE trips
+    DROP CONSTRAINT IF EXISTS trips_rsvp_deadline_check,
+    DROP COLUMN IF EXISTS "rsvp_closed_at",
+    DROP COLUMN IF EXISTS "rsvp_deadline";
\ No newline at end of file
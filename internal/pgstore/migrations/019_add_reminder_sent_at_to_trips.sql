@@ -0,0 +1,15 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "reminder_sent_at" TIMESTAMPTZ;
+
+-- Keeps the reminder lookup to the published trips still waiting for one,
+-- instead of scanning the whole table.
+CREATE INDEX IF NOT EXISTS trips_reminder_due_idx
+    ON trips ("starts_at")
+    WHERE "reminder_sent_at" IS NULL
+        AND "is_draft" = FALSE;
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_reminder_due_idx;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "reminder_sent_at";
\ No newline at end of file
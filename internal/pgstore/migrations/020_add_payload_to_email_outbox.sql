@@ -0,0 +1,6 @@
+ALTER TABLE email_outbox
+    ADD COLUMN IF NOT EXISTS "payload" JSONB;
+---- create above / drop below ----
+
+ALTER TABLE email_outbox
+    DROP COLUMN IF EXISTS "payload";
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Lists trips page by page in (starts_at, id) order. Undated drafts sort
+-- last, as if they started at infinity, so the cursor never has to deal
+-- with NULLs.
+CREATE INDEX IF NOT EXISTS trips_listing_idx
+    ON trips ((COALESCE("starts_at", 'infinity'::timestamptz)), "id");
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS trips_listing_idx;
\ No newline at end of file
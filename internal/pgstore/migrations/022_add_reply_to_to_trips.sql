@@ -0,0 +1,8 @@
+-- The organizer address replies to trip e-mails go to, the owner e-mail
+-- when unset. Messages are still sent from the system address.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "reply_to" TEXT;
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "reply_to";
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "pinned" BOOLEAN NOT NULL DEFAULT FALSE;
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "pinned";
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- The IANA time zone of the trip, JOURNEY_DEFAULT_TIMEZONE when the owner
+-- doesn't pick one.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "timezone" TEXT NOT NULL DEFAULT 'UTC';
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "timezone";
\ No newline at end of file
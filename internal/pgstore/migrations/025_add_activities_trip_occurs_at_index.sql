@@ -0,0 +1,7 @@
+-- Serves the activities of a trip within a time range, like the ones of
+-- today.
+CREATE INDEX IF NOT EXISTS activities_trip_occurs_at_idx
+    ON activities ("trip_id", "occurs_at");
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS activities_trip_occurs_at_idx;
\ No newline at end of file
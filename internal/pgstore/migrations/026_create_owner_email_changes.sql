@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS owner_email_changes (
+    "token" TEXT PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "email" VARCHAR(255) NOT NULL,
+    "expires_at" TIMESTAMPTZ NOT NULL,
+    "verified_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS owner_email_changes_trip_idx
+    ON owner_email_changes ("trip_id");
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS owner_email_changes;
\ No newline at end of file
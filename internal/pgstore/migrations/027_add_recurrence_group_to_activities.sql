@@ -0,0 +1,14 @@
+-- Occurrences of a recurring activity share the group, so they can be
+-- changed together.
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "recurrence_group" uuid;
+
+CREATE INDEX IF NOT EXISTS activities_recurrence_group_idx
+    ON activities ("recurrence_group")
+    WHERE "recurrence_group" IS NOT NULL;
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS activities_recurrence_group_idx;
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "recurrence_group";
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- Amounts are in the minor unit of the currency, cents for BRL or USD, so
+-- no float ever rounds them. An expense goes with its payer: the costs of a
+-- removed participant no longer belong in the split.
+CREATE TABLE IF NOT EXISTS expenses (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "description" VARCHAR(255) NOT NULL,
+    "amount" BIGINT NOT NULL CHECK ("amount" > 0),
+    "currency" VARCHAR(3) NOT NULL,
+    "payer_id" uuid NOT NULL,
+    "activity_id" uuid,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (payer_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS expenses_trip_created_at_idx
+    ON expenses ("trip_id", "created_at");
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS expenses;
\ No newline at end of file
@@ -0,0 +1,28 @@
+-- An item is assigned to a participant, to the trip owner, who has no
+-- participant row, or to no one. Removing a participant hands their items
+-- back instead of dropping them.
+CREATE TABLE IF NOT EXISTS checklist_items (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "title" VARCHAR(255) NOT NULL,
+    "position" INTEGER NOT NULL,
+    "assignee_id" uuid,
+    "assigned_to_owner" BOOLEAN NOT NULL DEFAULT FALSE,
+    "done" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CHECK (NOT ("assigned_to_owner" AND "assignee_id" IS NOT NULL)),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (assignee_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS checklist_items_trip_position_idx
+    ON checklist_items ("trip_id", "position");
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS checklist_items;
\ No newline at end of file
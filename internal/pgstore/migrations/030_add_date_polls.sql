@@ -0,0 +1,51 @@
+-- A polling trip has no dates yet, its participants vote on candidate ranges
+-- until the owner selects one. Each participant holds a single vote, voting
+-- again moves it.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "is_polling" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS trip_date_options (
+    "id" uuid PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "starts_at" TIMESTAMPTZ NOT NULL,
+    "ends_at" TIMESTAMPTZ NOT NULL,
+
+    CHECK ("ends_at" >= "starts_at"),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_date_options_trip_idx
+    ON trip_date_options ("trip_id");
+
+CREATE TABLE IF NOT EXISTS trip_date_votes (
+    "trip_id" uuid NOT NULL,
+    "participant_id" uuid NOT NULL,
+    "option_id" uuid NOT NULL,
+    "voted_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY ("trip_id", "participant_id"),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (option_id) REFERENCES trip_date_options(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_date_votes_option_idx
+    ON trip_date_votes ("option_id");
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_date_votes;
+
+DROP TABLE IF EXISTS trip_date_options;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "is_polling";
\ No newline at end of file
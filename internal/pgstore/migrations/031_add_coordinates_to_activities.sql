@@ -0,0 +1,14 @@
+-- Coordinates are set in pairs, an activity has both or neither.
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "latitude" DOUBLE PRECISION
+        CHECK ("latitude" BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS "longitude" DOUBLE PRECISION
+        CHECK ("longitude" BETWEEN -180 AND 180),
+    ADD CONSTRAINT activities_coordinates_pair_check
+        CHECK (("latitude" IS NULL) = ("longitude" IS NULL));
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_coordinates_pair_check,
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "latitude";
\ No newline at end of file
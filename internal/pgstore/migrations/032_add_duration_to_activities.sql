@@ -0,0 +1,7 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "duration_minutes" INTEGER
+        CHECK ("duration_minutes" > 0);
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "duration_minutes";
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Addresses no e-mail is sent to, whatever the trip. The key is the whole
+-- address lowercased, see SuppressionKey.
+CREATE TABLE IF NOT EXISTS email_suppressions (
+    "email" TEXT PRIMARY KEY NOT NULL,
+    "reason" VARCHAR(16) NOT NULL,
+    "note" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CHECK ("email" = lower(btrim("email"))),
+    CHECK ("reason" IN ('bounce', 'complaint', 'manual'))
+);
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_suppressions;
@@ -0,0 +1,13 @@
+-- The categories of optional e-mail each address opted out of, keyed like
+-- email_suppressions. Transactional e-mails ignore it.
+CREATE TABLE IF NOT EXISTS email_preferences (
+    "email" TEXT NOT NULL,
+    "category" VARCHAR(32) NOT NULL,
+    "unsubscribed_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY ("email", "category"),
+    CHECK ("email" = lower(btrim("email")))
+);
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_preferences;
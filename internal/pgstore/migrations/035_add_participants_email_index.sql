@@ -0,0 +1,7 @@
+-- Serves the itinerary of a participant, which looks up the trips they
+-- confirmed by e-mail.
+CREATE INDEX IF NOT EXISTS participants_confirmed_email_idx
+    ON participants ("email") WHERE "is_confirmed";
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_confirmed_email_idx;
@@ -0,0 +1,10 @@
+-- Test e-mails are logged in the outbox like every other send, they belong to
+-- no trip.
+ALTER TABLE email_outbox
+    ALTER COLUMN "trip_id" DROP NOT NULL;
+---- create above / drop below ----
+
+DELETE FROM email_outbox WHERE "trip_id" IS NULL;
+
+ALTER TABLE email_outbox
+    ALTER COLUMN "trip_id" SET NOT NULL;
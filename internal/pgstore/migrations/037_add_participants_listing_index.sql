@@ -0,0 +1,6 @@
+-- Lists the participants of a trip page by page in (email, id) order.
+CREATE INDEX IF NOT EXISTS participants_trip_listing_idx
+    ON participants ("trip_id", "email", "id");
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_trip_listing_idx;
@@ -0,0 +1,18 @@
+-- Removing a participant used to drop the expenses they paid, and with them
+-- part of the split. The participant now stays until their expenses go.
+-- NO ACTION rather than RESTRICT, the check runs at the end of the statement
+-- so deleting a trip still cascades to both.
+ALTER TABLE expenses
+    DROP CONSTRAINT IF EXISTS expenses_payer_id_fkey,
+    ADD CONSTRAINT expenses_payer_id_fkey
+        FOREIGN KEY (payer_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE NO ACTION;
+---- create above / drop below ----
+
+ALTER TABLE expenses
+    DROP CONSTRAINT IF EXISTS expenses_payer_id_fkey,
+    ADD CONSTRAINT expenses_payer_id_fkey
+        FOREIGN KEY (payer_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE;
@@ -10,10 +10,69 @@ import (
 )
 
 type Activity struct {
-	ID       uuid.UUID
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	ID                 uuid.UUID
+	TripID             uuid.UUID
+	Title              string
+	OccursAt           pgtype.Timestamptz
+	RemindBefore       pgtype.Int4
+	RemindParticipants bool
+	ReminderSentAt     pgtype.Timestamp
+	CreatedAt          pgtype.Timestamp
+	Pinned             bool
+	RecurrenceGroup    pgtype.UUID
+	Latitude           pgtype.Float8
+	Longitude          pgtype.Float8
+	DurationMinutes    pgtype.Int4
+}
+
+type ChecklistItem struct {
+	ID              uuid.UUID
+	TripID          uuid.UUID
+	Title           string
+	Position        int32
+	AssigneeID      pgtype.UUID
+	AssignedToOwner bool
+	Done            bool
+	CreatedAt       pgtype.Timestamptz
+}
+
+type EmailPreference struct {
+	Email          string
+	Category       string
+	UnsubscribedAt pgtype.Timestamptz
+}
+
+type EmailSuppression struct {
+	Email     string
+	Reason    string
+	Note      pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+type EmailOutbox struct {
+	ID            uuid.UUID
+	TripID        pgtype.UUID
+	Kind          string
+	Status        string
+	Attempts      int32
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+	ActivityID    pgtype.UUID
+	ParticipantID pgtype.UUID
+	RequestID     pgtype.Text
+	Payload       []byte
+}
+
+type Expense struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+	CreatedAt   pgtype.Timestamptz
 }
 
 type Link struct {
@@ -23,19 +82,57 @@ type Link struct {
 	Url    string
 }
 
+type OwnerEmailChange struct {
+	Token      string
+	TripID     uuid.UUID
+	Email      string
+	ExpiresAt  pgtype.Timestamptz
+	VerifiedAt pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+}
+
 type Participant struct {
-	ID          uuid.UUID
-	TripID      uuid.UUID
-	Email       string
-	IsConfirmed bool
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	Email         string
+	IsConfirmed   bool
+	Name          pgtype.Text
+	InviteMessage pgtype.Text
+	ExpiresAt     pgtype.Timestamp
+	PlusOnes      int32
+}
+
+type TripDateOption struct {
+	ID       uuid.UUID
+	TripID   uuid.UUID
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+type TripDateVote struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	OptionID      uuid.UUID
+	VotedAt       pgtype.Timestamptz
 }
 
 type Trip struct {
-	ID          uuid.UUID
-	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	IsConfirmed bool
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+	ID             uuid.UUID
+	Destination    string
+	OwnerEmail     string
+	OwnerName      string
+	IsConfirmed    bool
+	StartsAt       pgtype.Timestamptz
+	EndsAt         pgtype.Timestamptz
+	IsDraft        bool
+	InviteMessage  pgtype.Text
+	ConfirmedAt    pgtype.Timestamptz
+	Slug           string
+	Version        int32
+	RsvpDeadline   pgtype.Timestamptz
+	RsvpClosedAt   pgtype.Timestamptz
+	ReminderSentAt pgtype.Timestamptz
+	ReplyTo        pgtype.Text
+	Timezone       string
+	IsPolling      bool
 }
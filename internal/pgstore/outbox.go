@@ -0,0 +1,61 @@
+package pgstore
+
+import (
+	"context"
+	"journey/internal/logctx"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Kinds of e-mail stored in the email_outbox table.
+const (
+	// EmailKindConfirmTripOwner asks the trip owner to confirm a trip.
+	EmailKindConfirmTripOwner = "confirm_trip_owner"
+	// EmailKindActivityReminder warns about an upcoming activity, the row
+	// carries the activity_id. Each recipient gets a row of their own, the
+	// confirmed participants with their participant_id and the trip owner
+	// with none.
+	EmailKindActivityReminder = "activity_reminder"
+	// EmailKindParticipantInvite invites a participant to confirm, the row
+	// carries the participant_id.
+	EmailKindParticipantInvite = "participant_invite"
+	// EmailKindRSVPHeadcount tells the trip owner who confirmed once the RSVP
+	// deadline passed.
+	EmailKindRSVPHeadcount = "rsvp_headcount"
+	// EmailKindTripUpdated tells a confirmed participant the trip dates or
+	// destination changed, the row carries the participant_id and the changes
+	// as its payload.
+	EmailKindTripUpdated = "trip_updated"
+	// EmailKindOwnerEmailVerify asks the new owner address to verify itself,
+	// the row carries an OwnerEmailPayload with the token.
+	EmailKindOwnerEmailVerify = "owner_email_verify"
+	// EmailKindOwnerEmailChange tells the current owner address a change was
+	// asked, the row carries an OwnerEmailPayload.
+	EmailKindOwnerEmailChange = "owner_email_change"
+	// EmailKindTestEmail is a test e-mail an operator sent, the row has no
+	// trip and carries a TestEmailPayload. It is sent right away and only
+	// logged in the outbox, already sent or dead lettered.
+	EmailKindTestEmail = "test_email"
+)
+
+// Statuses of the e-mails stored in the email_outbox table, besides pending.
+const (
+	EmailStatusSent       = "sent"
+	EmailStatusDeadLetter = "dead_letter"
+)
+
+// TestEmailPayload is the payload of a test e-mail.
+type TestEmailPayload struct {
+	To string `json:"to"`
+}
+
+// RequestID is the ID of the request or the job run that queued an e-mail,
+// so its delivery can be traced back to it. It is null for anything else.
+func RequestID(ctx context.Context) pgtype.Text {
+	id := middleware.GetReqID(ctx)
+	if id == "" {
+		id = logctx.JobID(ctx)
+	}
+	return pgtype.Text{String: id, Valid: id != ""}
+}
@@ -0,0 +1,93 @@
+package pgstore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OwnerEmailPayload is the payload of the owner e-mail change e-mails. To is
+// where the e-mail goes, the current address gets no Token.
+type OwnerEmailPayload struct {
+	To       string `json:"to"`
+	NewEmail string `json:"new_email"`
+	Token    string `json:"token,omitempty"`
+}
+
+// newOwnerEmailToken draws the secret the new owner address verifies with.
+func newOwnerEmailToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// RequestOwnerEmailChange stores email as the pending owner address of the
+// trip, valid for ttl, and queues the verification e-mail to it along with a
+// notice to the current owner address. A pending change asked before is
+// dropped, only the latest token verifies.
+func (q *Queries) RequestOwnerEmailChange(ctx context.Context, pool *pgxpool.Pool, trip Trip, email string, ttl time.Duration) error {
+	return WithTx(ctx, pool, func(tx pgx.Tx) error {
+		return q.RequestOwnerEmailChangeTx(ctx, tx, trip, email, ttl)
+	})
+}
+
+// RequestOwnerEmailChangeTx is RequestOwnerEmailChange on the caller's
+// transaction.
+func (q *Queries) RequestOwnerEmailChangeTx(ctx context.Context, tx pgx.Tx, trip Trip, email string, ttl time.Duration) error {
+	token, err := newOwnerEmailToken()
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to draw token for RequestOwnerEmailChange: %w", err)
+	}
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.CreateOwnerEmailChange(ctx, CreateOwnerEmailChangeParams{
+		TripID:     trip.ID,
+		Token:      token,
+		Email:      email,
+		TtlSeconds: ttl.Seconds(),
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to store change for RequestOwnerEmailChange: %w", err)
+	}
+
+	if err := qtx.enqueueOwnerEmail(ctx, trip.ID, EmailKindOwnerEmailVerify, OwnerEmailPayload{
+		To:       email,
+		NewEmail: email,
+		Token:    token,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue verification for RequestOwnerEmailChange: %w", err)
+	}
+
+	if err := qtx.enqueueOwnerEmail(ctx, trip.ID, EmailKindOwnerEmailChange, OwnerEmailPayload{
+		To:       trip.OwnerEmail,
+		NewEmail: email,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue notice for RequestOwnerEmailChange: %w", err)
+	}
+
+	return nil
+}
+
+func (q *Queries) enqueueOwnerEmail(ctx context.Context, tripID uuid.UUID, kind string, p OwnerEmailPayload) error {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.EnqueueEmailWithPayload(ctx, EnqueueEmailWithPayloadParams{
+		TripID:    tripID,
+		Kind:      kind,
+		RequestID: RequestID(ctx),
+		Payload:   payload,
+	})
+	return err
+}
@@ -0,0 +1,53 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// foreignKeyViolationCode is raised by Postgres when a delete leaves
+	// rows referencing the deleted one.
+	foreignKeyViolationCode = "23503"
+	expensePayerKey         = "expenses_payer_id_fkey"
+)
+
+// MaxParticipantTrips caps how many trips GetParticipantsForTrips reads in
+// one call, the ANY array and the result both grow with it.
+const MaxParticipantTrips = 100
+
+// GetParticipantsForTrips reads the participants of every trip in one query
+// and groups them by trip, in e-mail order. Trips without participants, or
+// unknown, are missing from the map.
+func (q *RetryingQueries) GetParticipantsForTrips(ctx context.Context, tripIDs []uuid.UUID) (map[uuid.UUID][]Participant, error) {
+	if len(tripIDs) > MaxParticipantTrips {
+		return nil, fmt.Errorf("pgstore: GetParticipantsForTrips takes at most %d trips, got %d", MaxParticipantTrips, len(tripIDs))
+	}
+
+	participants, err := q.ListParticipantsForTrips(ctx, tripIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return GroupParticipants(participants), nil
+}
+
+// GroupParticipants groups participants by trip, keeping their order.
+func GroupParticipants(participants []Participant) map[uuid.UUID][]Participant {
+	byTrip := make(map[uuid.UUID][]Participant)
+	for _, participant := range participants {
+		byTrip[participant.TripID] = append(byTrip[participant.TripID], participant)
+	}
+	return byTrip
+}
+
+// IsExpensePayer reports whether err comes from removing a participant who
+// still paid expenses of the trip.
+func IsExpensePayer(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == expensePayerKey
+}
@@ -0,0 +1,56 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WithSimpleProtocol makes the pool run every query over the simple
+// protocol, with no prepared statements kept on the server. It is needed
+// behind poolers like pgbouncer in transaction mode, where consecutive
+// statements of a connection may reach different server sessions.
+//
+// Startup parameters such as statement_timeout still have to be allowed by
+// the pooler, see track_extra_parameters in pgbouncer.
+func WithSimpleProtocol(cfg *pgxpool.Config) {
+	cfg.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+	cfg.ConnConfig.StatementCacheCapacity = 0
+	cfg.ConnConfig.DescriptionCacheCapacity = 0
+}
+
+// usesSimpleProtocol reports whether conn was set up by WithSimpleProtocol.
+func usesSimpleProtocol(conn *pgx.Conn) bool {
+	return conn.Config().DefaultQueryExecMode == pgx.QueryExecModeSimpleProtocol
+}
+
+// insertParticipants writes the invites of a new trip. COPY needs an
+// extended protocol round trip to describe the table, so in simple protocol
+// mode the rows go in one multi-row INSERT instead.
+func (q *Queries) insertParticipants(ctx context.Context, tx pgx.Tx, rows []InviteParticipantsToTripParams) error {
+	if !usesSimpleProtocol(tx.Conn()) {
+		_, err := q.WithTx(tx).InviteParticipantsToTrip(ctx, rows)
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	sql.WriteString(`INSERT INTO participants ("id", "trip_id", "email", "expires_at") VALUES `)
+	args := make([]any, 0, len(rows)*4)
+	for i, row := range rows {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		n := len(args)
+		fmt.Fprintf(&sql, "($%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4)
+		args = append(args, row.ID, row.TripID, row.Email, row.ExpiresAt)
+	}
+
+	_, err := tx.Exec(ctx, sql.String(), args...)
+	return err
+}
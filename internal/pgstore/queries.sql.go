@@ -12,95 +12,2123 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const confirmParticipant = `-- name: ConfirmParticipant :exec
+const assignChecklistItem = `-- name: AssignChecklistItem :execrows
+UPDATE checklist_items
+SET "assignee_id" = $1,
+    "assigned_to_owner" = $2
+WHERE "id" = $3
+    AND "trip_id" = $4
+`
+
+type AssignChecklistItemParams struct {
+	AssigneeID      pgtype.UUID
+	AssignedToOwner bool
+	ID              uuid.UUID
+	TripID          uuid.UUID
+}
+
+func (q *Queries) AssignChecklistItem(ctx context.Context, arg AssignChecklistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, assignChecklistItem,
+		arg.AssigneeID,
+		arg.AssignedToOwner,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimDueEmails = `-- name: ClaimDueEmails :many
+UPDATE email_outbox
+SET "next_attempt_at" = now() + make_interval(secs => $1::float8)
+WHERE "id" IN (
+        SELECT "id"
+        FROM email_outbox
+        WHERE "status" = 'pending'
+            AND "next_attempt_at" <= now()
+        ORDER BY "next_attempt_at"
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id",
+    "trip_id",
+    "kind",
+    "status",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+    "created_at",
+    "activity_id",
+    "participant_id",
+    "request_id",
+    "payload"
+`
+
+type ClaimDueEmailsParams struct {
+	LeaseSeconds float64
+	BatchSize    int32
+}
+
+func (q *Queries) ClaimDueEmails(ctx context.Context, arg ClaimDueEmailsParams) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, claimDueEmails, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Kind,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.CreatedAt,
+			&i.ActivityID,
+			&i.ParticipantID,
+			&i.RequestID,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const closeDueRSVPs = `-- name: CloseDueRSVPs :execrows
+WITH closed AS (
+    UPDATE trips
+    SET "rsvp_closed_at" = now()
+    WHERE "rsvp_deadline" <= now()
+        AND "rsvp_closed_at" IS NULL
+    RETURNING "id"
+),
+expired AS (
+    UPDATE participants
+    SET "expires_at" = now() AT TIME ZONE 'UTC'
+    FROM closed
+    WHERE participants."trip_id" = closed."id"
+        AND participants."is_confirmed" = FALSE
+        AND (
+            participants."expires_at" IS NULL
+            OR participants."expires_at" > now() AT TIME ZONE 'UTC'
+        )
+)
+INSERT INTO email_outbox ("trip_id", "kind", "request_id")
+SELECT "id",
+    $1,
+    $2
+FROM closed
+`
+
+type CloseDueRSVPsParams struct {
+	Kind      string
+	RequestID pgtype.Text
+}
+
+func (q *Queries) CloseDueRSVPs(ctx context.Context, arg CloseDueRSVPsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, closeDueRSVPs, arg.Kind, arg.RequestID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const confirmParticipant = `-- name: ConfirmParticipant :execrows
 UPDATE participants
-SET "is_confirmed" = TRUE
-WHERE id = $1
+SET "is_confirmed" = TRUE,
+    "plus_ones" = COALESCE($1, "plus_ones")
+WHERE id = $2
+    AND "is_confirmed" = FALSE
 `
 
-func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmParticipant, id)
-	return err
+type ConfirmParticipantParams struct {
+	PlusOnes pgtype.Int4
+	ID       uuid.UUID
+}
+
+func (q *Queries) ConfirmParticipant(ctx context.Context, arg ConfirmParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmParticipant, arg.PlusOnes, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const confirmTripIfUnconfirmed = `-- name: ConfirmTripIfUnconfirmed :one
+UPDATE trips
+SET "is_confirmed" = TRUE,
+    "confirmed_at" = now(),
+    "version" = "version" + 1
+WHERE "id" = $1
+    AND "is_confirmed" = FALSE
+RETURNING "id"
+`
+
+func (q *Queries) ConfirmTripIfUnconfirmed(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, confirmTripIfUnconfirmed, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const countDeadLetterEmails = `-- name: CountDeadLetterEmails :one
+SELECT COUNT(*)
+FROM email_outbox
+WHERE "status" = 'dead_letter'
+`
+
+func (q *Queries) CountDeadLetterEmails(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countDeadLetterEmails)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRecentParticipantEmails = `-- name: CountRecentParticipantEmails :one
+SELECT COUNT(*)
+FROM email_outbox
+WHERE "participant_id" = $1
+    AND "kind" = $2
+    AND "created_at" > now() - make_interval(secs => $3::float8)
+`
+
+type CountRecentParticipantEmailsParams struct {
+	ParticipantID pgtype.UUID
+	Kind          string
+	WindowSeconds float64
+}
+
+func (q *Queries) CountRecentParticipantEmails(ctx context.Context, arg CountRecentParticipantEmailsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecentParticipantEmails, arg.ParticipantID, arg.Kind, arg.WindowSeconds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripLinks = `-- name: CountTripLinks :one
+SELECT COUNT(*)
+FROM links
+WHERE "trip_id" = $1
+`
+
+func (q *Queries) CountTripLinks(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripLinks, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const createActivity = `-- name: CreateActivity :one
 INSERT INTO activities (
+        "id",
         "trip_id",
         "title",
-        "occurs_at"
+        "occurs_at",
+        "remind_before",
+        "remind_participants",
+        "recurrence_group",
+        "latitude",
+        "longitude",
+        "duration_minutes"
     )
-VALUES ($1, $2, $3)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING "id"
 `
 
 type CreateActivityParams struct {
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	ID                 uuid.UUID
+	TripID             uuid.UUID
+	Title              string
+	OccursAt           pgtype.Timestamptz
+	RemindBefore       pgtype.Int4
+	RemindParticipants bool
+	RecurrenceGroup    pgtype.UUID
+	Latitude           pgtype.Float8
+	Longitude          pgtype.Float8
+	DurationMinutes    pgtype.Int4
 }
 
 func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.ID,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.RemindBefore,
+		arg.RemindParticipants,
+		arg.RecurrenceGroup,
+		arg.Latitude,
+		arg.Longitude,
+		arg.DurationMinutes,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
+const createChecklistItem = `-- name: CreateChecklistItem :exec
+INSERT INTO checklist_items (
+        "id",
+        "trip_id",
+        "title",
+        "position",
+        "assignee_id",
+        "assigned_to_owner"
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        (
+            SELECT COALESCE(MAX("position"), 0) + 1
+            FROM checklist_items
+            WHERE "trip_id" = $2
+        ),
+        $4,
+        $5
+    )
+`
+
+type CreateChecklistItemParams struct {
+	ID              uuid.UUID
+	TripID          uuid.UUID
+	Title           string
+	AssigneeID      pgtype.UUID
+	AssignedToOwner bool
+}
+
+func (q *Queries) CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) error {
+	_, err := q.db.Exec(ctx, createChecklistItem,
+		arg.ID,
+		arg.TripID,
+		arg.Title,
+		arg.AssigneeID,
+		arg.AssignedToOwner,
+	)
+	return err
+}
+
+const createExpense = `-- name: CreateExpense :execrows
+INSERT INTO expenses (
+        "id",
+        "trip_id",
+        "description",
+        "amount",
+        "currency",
+        "payer_id",
+        "activity_id"
+    )
+SELECT $1::uuid,
+    $2::uuid,
+    $3::text,
+    $4::bigint,
+    $5::text,
+    $6::uuid,
+    $7::uuid
+WHERE NOT EXISTS (
+        SELECT 1
+        FROM expenses
+        WHERE "trip_id" = $2::uuid
+            AND "currency" <> $5::text
+    )
+`
+
+type CreateExpenseParams struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+}
+
+func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createExpense,
+		arg.ID,
+		arg.TripID,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.PayerID,
+		arg.ActivityID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createOwnerEmailChange = `-- name: CreateOwnerEmailChange :exec
+WITH superseded AS (
+    DELETE FROM owner_email_changes
+    WHERE "trip_id" = $1
+        AND "verified_at" IS NULL
+)
+INSERT INTO owner_email_changes ("token", "trip_id", "email", "expires_at")
+VALUES (
+    $2,
+    $1,
+    $3,
+    now() + make_interval(secs => $4::float8)
+)
+`
+
+type CreateOwnerEmailChangeParams struct {
+	TripID     uuid.UUID
+	Token      string
+	Email      string
+	TtlSeconds float64
+}
+
+func (q *Queries) CreateOwnerEmailChange(ctx context.Context, arg CreateOwnerEmailChangeParams) error {
+	_, err := q.db.Exec(ctx, createOwnerEmailChange,
+		arg.TripID,
+		arg.Token,
+		arg.Email,
+		arg.TtlSeconds,
+	)
+	return err
+}
+
 const createTripLink = `-- name: CreateTripLink :one
 INSERT INTO links (
+        "id",
         "trip_id",
         "title",
         "url"
     )
-VALUES ($1, $2, $3)
+VALUES ($1, $2, $3, $4)
 RETURNING "id"
 `
 
 type CreateTripLinkParams struct {
+	ID     uuid.UUID
 	TripID uuid.UUID
 	Title  string
 	Url    string
 }
 
 func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
+	row := q.db.QueryRow(ctx, createTripLink,
+		arg.ID,
+		arg.TripID,
+		arg.Title,
+		arg.Url,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
-const getParticipant = `-- name: GetParticipant :one
+const deferEmail = `-- name: DeferEmail :execrows
+UPDATE email_outbox
+SET "next_attempt_at" = "created_at" + make_interval(secs => $1::float8)
+WHERE "id" = $2
+    AND "created_at" + make_interval(secs => $1::float8) > now()
+`
+
+type DeferEmailParams struct {
+	DelaySeconds float64
+	ID           uuid.UUID
+}
+
+func (q *Queries) DeferEmail(ctx context.Context, arg DeferEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deferEmail, arg.DelaySeconds, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteActivities = `-- name: DeleteActivities :many
+DELETE FROM activities
+WHERE "trip_id" = $1
+    AND (
+        "id" = $2
+        OR (
+            $3::bool
+            AND "recurrence_group" = (
+                SELECT "recurrence_group"
+                FROM activities
+                WHERE "id" = $2
+                    AND "trip_id" = $1
+            )
+        )
+    )
+RETURNING "id"
+`
+
+type DeleteActivitiesParams struct {
+	TripID     uuid.UUID
+	ID         uuid.UUID
+	WholeGroup bool
+}
+
+func (q *Queries) DeleteActivities(ctx context.Context, arg DeleteActivitiesParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteActivities, arg.TripID, arg.ID, arg.WholeGroup)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteChecklistItem = `-- name: DeleteChecklistItem :execrows
+DELETE FROM checklist_items
+WHERE "id" = $1
+    AND "trip_id" = $2
+`
+
+type DeleteChecklistItemParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteChecklistItem(ctx context.Context, arg DeleteChecklistItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChecklistItem, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDuplicateActivities = `-- name: DeleteDuplicateActivities :many
+DELETE FROM activities
+WHERE "trip_id" = $1
+    AND "id" NOT IN (
+        SELECT DISTINCT ON ("title", "occurs_at") "id"
+        FROM activities
+        WHERE "trip_id" = $1
+        ORDER BY "title", "occurs_at", "created_at", "id"
+    )
+RETURNING "id"
+`
+
+func (q *Queries) DeleteDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteDuplicateActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteEmailSuppression = `-- name: DeleteEmailSuppression :execrows
+DELETE FROM email_suppressions
+WHERE "email" = $1
+`
+
+func (q *Queries) DeleteEmailSuppression(ctx context.Context, email string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmailSuppression, email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpense = `-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE "id" = $1
+    AND "trip_id" = $2
+`
+
+type DeleteExpenseParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteExpense(ctx context.Context, arg DeleteExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpense, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueEmail = `-- name: EnqueueEmail :one
+INSERT INTO email_outbox ("trip_id", "kind", "request_id")
+VALUES ($1::uuid, $2, $3)
+RETURNING "id"
+`
+
+type EnqueueEmailParams struct {
+	TripID    uuid.UUID
+	Kind      string
+	RequestID pgtype.Text
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, enqueueEmail, arg.TripID, arg.Kind, arg.RequestID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const enqueueEmailWithPayload = `-- name: EnqueueEmailWithPayload :one
+INSERT INTO email_outbox ("trip_id", "kind", "request_id", "payload")
+VALUES ($1::uuid, $2, $3, $4)
+RETURNING "id"
+`
+
+type EnqueueEmailWithPayloadParams struct {
+	TripID    uuid.UUID
+	Kind      string
+	RequestID pgtype.Text
+	Payload   []byte
+}
+
+func (q *Queries) EnqueueEmailWithPayload(ctx context.Context, arg EnqueueEmailWithPayloadParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, enqueueEmailWithPayload,
+		arg.TripID,
+		arg.Kind,
+		arg.RequestID,
+		arg.Payload,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const enqueueParticipantEmail = `-- name: EnqueueParticipantEmail :one
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id")
+VALUES ($1::uuid, $2, $3, $4)
+RETURNING "id"
+`
+
+type EnqueueParticipantEmailParams struct {
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Kind          string
+	RequestID     pgtype.Text
+}
+
+func (q *Queries) EnqueueParticipantEmail(ctx context.Context, arg EnqueueParticipantEmailParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, enqueueParticipantEmail,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Kind,
+		arg.RequestID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const enqueueTripInvites = `-- name: EnqueueTripInvites :execrows
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id")
+SELECT "trip_id",
+    "id",
+    $1,
+    $2
+FROM participants
+WHERE "trip_id" = $3
+    AND "is_confirmed" = FALSE
+`
+
+type EnqueueTripInvitesParams struct {
+	Kind      string
+	RequestID pgtype.Text
+	TripID    uuid.UUID
+}
+
+func (q *Queries) EnqueueTripInvites(ctx context.Context, arg EnqueueTripInvitesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueTripInvites, arg.Kind, arg.RequestID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueTripUpdatedEmails = `-- name: EnqueueTripUpdatedEmails :execrows
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id", "payload")
+SELECT "trip_id",
+    "id",
+    $1,
+    $2,
+    $3
+FROM participants
+WHERE "trip_id" = $4
+    AND "is_confirmed" = TRUE
+`
+
+type EnqueueTripUpdatedEmailsParams struct {
+	Kind      string
+	RequestID pgtype.Text
+	Payload   []byte
+	TripID    uuid.UUID
+}
+
+func (q *Queries) EnqueueTripUpdatedEmails(ctx context.Context, arg EnqueueTripUpdatedEmailsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueTripUpdatedEmails,
+		arg.Kind,
+		arg.RequestID,
+		arg.Payload,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const extendParticipantInvite = `-- name: ExtendParticipantInvite :exec
+UPDATE participants
+SET "expires_at" = $1
+WHERE id = $2
+`
+
+type ExtendParticipantInviteParams struct {
+	ExpiresAt pgtype.Timestamp
+	ID        uuid.UUID
+}
+
+func (q *Queries) ExtendParticipantInvite(ctx context.Context, arg ExtendParticipantInviteParams) error {
+	_, err := q.db.Exec(ctx, extendParticipantInvite, arg.ExpiresAt, arg.ID)
+	return err
+}
+
+const getActivitiesForTrips = `-- name: GetActivitiesForTrips :many
 SELECT "id",
     "trip_id",
-    "email",
-    "is_confirmed"
-FROM participants
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = ANY($1::uuid[])
+ORDER BY "trip_id", date_trunc('day', "occurs_at", (SELECT t."timezone" FROM trips t WHERE t."id" = activities."trip_id")), "pinned" DESC, "occurs_at", "id"
+`
+
+func (q *Queries) GetActivitiesForTrips(ctx context.Context, tripIds []uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getActivitiesForTrips, tripIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBefore,
+			&i.RemindParticipants,
+			&i.ReminderSentAt,
+			&i.CreatedAt,
+			&i.Pinned,
+			&i.RecurrenceGroup,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DurationMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "id" = $1
+`
+
+func (q *Queries) GetActivity(ctx context.Context, id uuid.UUID) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, id)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.RemindBefore,
+		&i.RemindParticipants,
+		&i.ReminderSentAt,
+		&i.CreatedAt,
+		&i.Pinned,
+		&i.RecurrenceGroup,
+		&i.Latitude,
+		&i.Longitude,
+		&i.DurationMinutes,
+	)
+	return i, err
+}
+
+const getDeadLetterEmails = `-- name: GetDeadLetterEmails :many
+SELECT "id",
+    "trip_id",
+    "kind",
+    "status",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+    "created_at",
+    "activity_id",
+    "participant_id",
+    "request_id",
+    "payload"
+FROM email_outbox
+WHERE "status" = 'dead_letter'
+ORDER BY "created_at"
+`
+
+func (q *Queries) GetDeadLetterEmails(ctx context.Context) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, getDeadLetterEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Kind,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.CreatedAt,
+			&i.ActivityID,
+			&i.ParticipantID,
+			&i.RequestID,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDuplicateActivities = `-- name: GetDuplicateActivities :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = $1
+    AND ("title", "occurs_at") IN (
+        SELECT "title",
+            "occurs_at"
+        FROM activities
+        WHERE "trip_id" = $1
+        GROUP BY "title",
+            "occurs_at"
+        HAVING COUNT(*) > 1
+    )
+ORDER BY "occurs_at", "title", "created_at", "id"
+`
+
+func (q *Queries) GetDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getDuplicateActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBefore,
+			&i.RemindParticipants,
+			&i.ReminderSentAt,
+			&i.CreatedAt,
+			&i.Pinned,
+			&i.RecurrenceGroup,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DurationMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGlobalStats = `-- name: GetGlobalStats :one
+SELECT (SELECT COUNT(*) FROM trips) AS trips,
+    (SELECT COUNT(*) FROM trips WHERE "is_confirmed") AS confirmed_trips,
+    (SELECT COUNT(*) FROM activities) AS activities,
+    (SELECT COUNT(*) FROM participants) AS participants
+`
+
+type GetGlobalStatsRow struct {
+	Trips          int64
+	ConfirmedTrips int64
+	Activities     int64
+	Participants   int64
+}
+
+func (q *Queries) GetGlobalStats(ctx context.Context) (GetGlobalStatsRow, error) {
+	row := q.db.QueryRow(ctx, getGlobalStats)
+	var i GetGlobalStatsRow
+	err := row.Scan(
+		&i.Trips,
+		&i.ConfirmedTrips,
+		&i.Activities,
+		&i.Participants,
+	)
+	return i, err
+}
+
+const getOverlappingTrips = `-- name: GetOverlappingTrips :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "owner_email" = $1
+    AND "is_draft" = FALSE
+    AND "ends_at" >= $2
+    AND "starts_at" <= $3
+ORDER BY "starts_at"
+`
+
+type GetOverlappingTripsParams struct {
+	OwnerEmail string
+	StartsAt   pgtype.Timestamptz
+	EndsAt     pgtype.Timestamptz
+}
+
+func (q *Queries) GetOverlappingTrips(ctx context.Context, arg GetOverlappingTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getOverlappingTrips, arg.OwnerEmail, arg.StartsAt, arg.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsDraft,
+			&i.InviteMessage,
+			&i.ConfirmedAt,
+			&i.Slug,
+			&i.Version,
+			&i.RsvpDeadline,
+			&i.RsvpClosedAt,
+			&i.ReminderSentAt,
+			&i.ReplyTo,
+			&i.Timezone,
+			&i.IsPolling,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT "id",
+    "trip_id",
+    "email",
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
+FROM participants
+WHERE "id" = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.Name,
+		&i.InviteMessage,
+		&i.ExpiresAt,
+		&i.PlusOnes,
+	)
+	return i, err
+}
+
+const getParticipantActivities = `-- name: GetParticipantActivities :many
+SELECT a."id",
+    a."trip_id",
+    a."title",
+    a."occurs_at",
+    a."pinned",
+    a."recurrence_group",
+    a."latitude",
+    a."longitude",
+    a."duration_minutes",
+    t."destination"
+FROM (
+        SELECT DISTINCT "trip_id"
+        FROM participants
+        WHERE "email" = $1
+            AND "is_confirmed"
+    ) p
+    JOIN activities a ON a."trip_id" = p."trip_id"
+    JOIN trips t ON t."id" = p."trip_id"
+WHERE (
+        $2::bool
+        OR a."occurs_at" >= now()
+    )
+    AND (a."occurs_at", a."id") > (
+        $3::timestamptz,
+        $4::uuid
+    )
+ORDER BY a."occurs_at", a."id"
+LIMIT $5
+OFFSET $6
+`
+
+type GetParticipantActivitiesParams struct {
+	Email         string
+	IncludePast   bool
+	AfterOccursAt pgtype.Timestamptz
+	AfterID       uuid.UUID
+	Limit         int32
+	Offset        int32
+}
+
+type GetParticipantActivitiesRow struct {
+	ID              uuid.UUID
+	TripID          uuid.UUID
+	Title           string
+	OccursAt        pgtype.Timestamptz
+	Pinned          bool
+	RecurrenceGroup pgtype.UUID
+	Latitude        pgtype.Float8
+	Longitude       pgtype.Float8
+	DurationMinutes pgtype.Int4
+	Destination     string
+}
+
+func (q *Queries) GetParticipantActivities(ctx context.Context, arg GetParticipantActivitiesParams) ([]GetParticipantActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, getParticipantActivities,
+		arg.Email,
+		arg.IncludePast,
+		arg.AfterOccursAt,
+		arg.AfterID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetParticipantActivitiesRow
+	for rows.Next() {
+		var i GetParticipantActivitiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Pinned,
+			&i.RecurrenceGroup,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DurationMinutes,
+			&i.Destination,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT "id",
+    "trip_id",
+    "email",
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
+FROM participants
+WHERE "id" = $1
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, id uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.InviteMessage,
+			&i.ExpiresAt,
+			&i.PlusOnes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSuppressedEmails = `-- name: GetSuppressedEmails :many
+SELECT "email"
+FROM email_suppressions
+WHERE "email" = ANY($1::text[])
+`
+
+func (q *Queries) GetSuppressedEmails(ctx context.Context, emails []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getSuppressedEmails, emails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "id" = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.IsConfirmed,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.IsDraft,
+		&i.InviteMessage,
+		&i.ConfirmedAt,
+		&i.Slug,
+		&i.Version,
+		&i.RsvpDeadline,
+		&i.RsvpClosedAt,
+		&i.ReminderSentAt,
+		&i.ReplyTo,
+		&i.Timezone,
+		&i.IsPolling,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = $1
+    AND (
+        $2::text = ''
+        OR unaccent("title") ILIKE unaccent('%' || $2::text || '%')
+    )
+ORDER BY date_trunc('day', "occurs_at", (SELECT t."timezone" FROM trips t WHERE t."id" = activities."trip_id")), "pinned" DESC, "occurs_at", "id"
+`
+
+type GetTripActivitiesParams struct {
+	TripID uuid.UUID
+	Query  string
+}
+
+func (q *Queries) GetTripActivities(ctx context.Context, arg GetTripActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, arg.TripID, arg.Query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBefore,
+			&i.RemindParticipants,
+			&i.ReminderSentAt,
+			&i.CreatedAt,
+			&i.Pinned,
+			&i.RecurrenceGroup,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DurationMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivitiesBetween = `-- name: GetTripActivitiesBetween :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = $1
+    AND "occurs_at" >= $2
+    AND "occurs_at" < $3
+ORDER BY "occurs_at", "pinned" DESC, "id"
+`
+
+type GetTripActivitiesBetweenParams struct {
+	TripID uuid.UUID
+	From   pgtype.Timestamptz
+	To     pgtype.Timestamptz
+}
+
+func (q *Queries) GetTripActivitiesBetween(ctx context.Context, arg GetTripActivitiesBetweenParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivitiesBetween, arg.TripID, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBefore,
+			&i.RemindParticipants,
+			&i.ReminderSentAt,
+			&i.CreatedAt,
+			&i.Pinned,
+			&i.RecurrenceGroup,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DurationMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityCountsPerDay = `-- name: GetTripActivityCountsPerDay :many
+SELECT date_trunc('day', "occurs_at", 'UTC')::timestamptz AS day,
+    COUNT(*) AS total
+FROM activities
+WHERE "trip_id" = $1
+GROUP BY day
+ORDER BY day
+`
+
+type GetTripActivityCountsPerDayRow struct {
+	Day   pgtype.Timestamptz
+	Total int64
+}
+
+func (q *Queries) GetTripActivityCountsPerDay(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityCountsPerDayRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivityCountsPerDay, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripActivityCountsPerDayRow
+	for rows.Next() {
+		var i GetTripActivityCountsPerDayRow
+		if err := rows.Scan(&i.Day, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityStats = `-- name: GetTripActivityStats :one
+SELECT COUNT(*) AS total,
+    MIN("occurs_at")::timestamptz AS earliest_at,
+    MAX("occurs_at")::timestamptz AS latest_at
+FROM activities
+WHERE "trip_id" = $1
+`
+
+type GetTripActivityStatsRow struct {
+	Total      int64
+	EarliestAt pgtype.Timestamptz
+	LatestAt   pgtype.Timestamptz
+}
+
+func (q *Queries) GetTripActivityStats(ctx context.Context, tripID uuid.UUID) (GetTripActivityStatsRow, error) {
+	row := q.db.QueryRow(ctx, getTripActivityStats, tripID)
+	var i GetTripActivityStatsRow
+	err := row.Scan(&i.Total, &i.EarliestAt, &i.LatestAt)
+	return i, err
+}
+
+const getTripChecklist = `-- name: GetTripChecklist :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "position",
+    "assignee_id",
+    "assigned_to_owner",
+    "done",
+    "created_at"
+FROM checklist_items
+WHERE "trip_id" = $1
+ORDER BY "position", "id"
+`
+
+func (q *Queries) GetTripChecklist(ctx context.Context, tripID uuid.UUID) ([]ChecklistItem, error) {
+	rows, err := q.db.Query(ctx, getTripChecklist, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChecklistItem
+	for rows.Next() {
+		var i ChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Position,
+			&i.AssigneeID,
+			&i.AssignedToOwner,
+			&i.Done,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripDateOptionTallies = `-- name: GetTripDateOptionTallies :many
+SELECT o."id",
+    o."starts_at",
+    o."ends_at",
+    COUNT(v."participant_id") AS votes
+FROM trip_date_options o
+    LEFT JOIN trip_date_votes v ON v."option_id" = o."id"
+WHERE o."trip_id" = $1
+GROUP BY o."id"
+ORDER BY o."starts_at",
+    o."id"
+`
+
+type GetTripDateOptionTalliesRow struct {
+	ID       uuid.UUID
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+	Votes    int64
+}
+
+func (q *Queries) GetTripDateOptionTallies(ctx context.Context, tripID uuid.UUID) ([]GetTripDateOptionTalliesRow, error) {
+	rows, err := q.db.Query(ctx, getTripDateOptionTallies, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripDateOptionTalliesRow
+	for rows.Next() {
+		var i GetTripDateOptionTalliesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Votes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenseTotals = `-- name: GetTripExpenseTotals :many
+SELECT "payer_id",
+    "currency",
+    SUM("amount")::bigint AS total
+FROM expenses
+WHERE "trip_id" = $1
+GROUP BY "payer_id", "currency"
+ORDER BY "payer_id"
+`
+
+type GetTripExpenseTotalsRow struct {
+	PayerID  uuid.UUID
+	Currency string
+	Total    int64
+}
+
+func (q *Queries) GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]GetTripExpenseTotalsRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpenseTotals, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpenseTotalsRow
+	for rows.Next() {
+		var i GetTripExpenseTotalsRow
+		if err := rows.Scan(&i.PayerID, &i.Currency, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenses = `-- name: GetTripExpenses :many
+SELECT "id",
+    "trip_id",
+    "description",
+    "amount",
+    "currency",
+    "payer_id",
+    "activity_id",
+    "created_at"
+FROM expenses
+WHERE "trip_id" = $1
+ORDER BY "created_at", "id"
+`
+
+func (q *Queries) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]Expense, error) {
+	rows, err := q.db.Query(ctx, getTripExpenses, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.PayerID,
+			&i.ActivityID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripIDBySlug = `-- name: GetTripIDBySlug :one
+SELECT "id"
+FROM trips
+WHERE "slug" = $1
+`
+
+func (q *Queries) GetTripIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getTripIDBySlug, slug)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getTripLink = `-- name: GetTripLink :one
+SELECT "id",
+    "trip_id",
+    "title",
+    "url"
+FROM links
 WHERE "id" = $1
+    AND "trip_id" = $2
+`
+
+type GetTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetTripLink(ctx context.Context, arg GetTripLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getTripLink, arg.ID, arg.TripID)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Url,
+	)
+	return i, err
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "url"
+FROM links
+WHERE "trip_id" = $1
+ORDER BY "id"
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantStats = `-- name: GetTripParticipantStats :one
+SELECT COUNT(*) AS invited,
+    COUNT(*) FILTER (WHERE "is_confirmed") AS confirmed,
+    COALESCE(SUM(1 + "plus_ones") FILTER (WHERE "is_confirmed"), 0)::bigint AS headcount
+FROM participants
+WHERE "trip_id" = $1
+`
+
+type GetTripParticipantStatsRow struct {
+	Invited   int64
+	Confirmed int64
+	Headcount int64
+}
+
+func (q *Queries) GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (GetTripParticipantStatsRow, error) {
+	row := q.db.QueryRow(ctx, getTripParticipantStats, tripID)
+	var i GetTripParticipantStatsRow
+	err := row.Scan(&i.Invited, &i.Confirmed, &i.Headcount)
+	return i, err
+}
+
+const getTripParticipants = `-- name: GetTripParticipants :many
+SELECT "id",
+    "trip_id",
+    "email",
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
+FROM participants
+WHERE "trip_id" = $1
+    AND (
+        $2::text = ''
+        OR unaccent("email") ILIKE unaccent('%' || $2::text || '%')
+        OR unaccent(COALESCE("name", '')) ILIKE unaccent('%' || $2::text || '%')
+    )
+    AND ("email", "id") > ($3::text, $4::uuid)
+ORDER BY "email", "id"
+LIMIT $5
+OFFSET $6
+`
+
+type GetTripParticipantsParams struct {
+	TripID     uuid.UUID
+	Query      string
+	AfterEmail string
+	AfterID    uuid.UUID
+	Limit      int32
+	Offset     int32
+}
+
+func (q *Queries) GetTripParticipants(ctx context.Context, arg GetTripParticipantsParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getTripParticipants,
+		arg.TripID,
+		arg.Query,
+		arg.AfterEmail,
+		arg.AfterID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.InviteMessage,
+			&i.ExpiresAt,
+			&i.PlusOnes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripVersion = `-- name: GetTripVersion :one
+SELECT "version"
+FROM trips
+WHERE id = $1
+`
+
+func (q *Queries) GetTripVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getTripVersion, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const getTripsByIDs = `-- name: GetTripsByIDs :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "id" = ANY($1::uuid[])
+`
+
+func (q *Queries) GetTripsByIDs(ctx context.Context, ids []uuid.UUID) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTripsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsDraft,
+			&i.InviteMessage,
+			&i.ConfirmedAt,
+			&i.Slug,
+			&i.Version,
+			&i.RsvpDeadline,
+			&i.RsvpClosedAt,
+			&i.ReminderSentAt,
+			&i.ReplyTo,
+			&i.Timezone,
+			&i.IsPolling,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsDueForReminder = `-- name: GetTripsDueForReminder :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "reminder_sent_at" IS NULL
+    AND "is_draft" = FALSE
+    AND "starts_at" > $1
+    AND "starts_at" <= $1 + make_interval(secs => $2::float8)
+ORDER BY "starts_at"
+`
+
+type GetTripsDueForReminderParams struct {
+	Now           pgtype.Timestamptz
+	WindowSeconds float64
+}
+
+func (q *Queries) GetTripsDueForReminder(ctx context.Context, arg GetTripsDueForReminderParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTripsDueForReminder, arg.Now, arg.WindowSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsDraft,
+			&i.InviteMessage,
+			&i.ConfirmedAt,
+			&i.Slug,
+			&i.Version,
+			&i.RsvpDeadline,
+			&i.RsvpClosedAt,
+			&i.ReminderSentAt,
+			&i.ReplyTo,
+			&i.Timezone,
+			&i.IsPolling,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnsubscribedEmails = `-- name: GetUnsubscribedEmails :many
+SELECT "email"
+FROM email_preferences
+WHERE "category" = $1
+    AND "email" = ANY($2::text[])
+`
+
+type GetUnsubscribedEmailsParams struct {
+	Category string
+	Emails   []string
+}
+
+func (q *Queries) GetUnsubscribedEmails(ctx context.Context, arg GetUnsubscribedEmailsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getUnsubscribedEmails, arg.Category, arg.Emails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const healthCheck = `-- name: HealthCheck :exec
+SELECT 1
+FROM trips
+LIMIT 1
+`
+
+func (q *Queries) HealthCheck(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, healthCheck)
+	return err
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT INTO trips (
+        "id",
+        "destination",
+        "owner_email",
+        "owner_name",
+        "starts_at",
+        "ends_at",
+        "is_draft",
+        "invite_message",
+        "slug",
+        "rsvp_deadline",
+        "reply_to",
+        "timezone",
+        "is_polling"
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	ID            uuid.UUID
+	Destination   string
+	OwnerEmail    string
+	OwnerName     string
+	StartsAt      pgtype.Timestamptz
+	EndsAt        pgtype.Timestamptz
+	IsDraft       bool
+	InviteMessage pgtype.Text
+	Slug          string
+	RsvpDeadline  pgtype.Timestamptz
+	ReplyTo       pgtype.Text
+	Timezone      string
+	IsPolling     bool
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.ID,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.IsDraft,
+		arg.InviteMessage,
+		arg.Slug,
+		arg.RsvpDeadline,
+		arg.ReplyTo,
+		arg.Timezone,
+		arg.IsPolling,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTripDateOption = `-- name: InsertTripDateOption :exec
+INSERT INTO trip_date_options ("id", "trip_id", "starts_at", "ends_at")
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertTripDateOptionParams struct {
+	ID       uuid.UUID
+	TripID   uuid.UUID
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+func (q *Queries) InsertTripDateOption(ctx context.Context, arg InsertTripDateOptionParams) error {
+	_, err := q.db.Exec(ctx, insertTripDateOption,
+		arg.ID,
+		arg.TripID,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	return err
+}
+
+const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
+INSERT INTO participants (
+        "id",
+        "trip_id",
+        "email",
+        "invite_message",
+        "expires_at",
+        "plus_ones"
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING "id"
+`
+
+type InviteParticipantToTripParams struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	Email         string
+	InviteMessage pgtype.Text
+	ExpiresAt     pgtype.Timestamp
+	PlusOnes      int32
+}
+
+func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, inviteParticipantToTrip,
+		arg.ID,
+		arg.TripID,
+		arg.Email,
+		arg.InviteMessage,
+		arg.ExpiresAt,
+		arg.PlusOnes,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InviteParticipantsToTripParams struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Email     string
+	ExpiresAt pgtype.Timestamp
+}
+
+const listEmailSuppressions = `-- name: ListEmailSuppressions :many
+SELECT "email",
+    "reason",
+    "note",
+    "created_at"
+FROM email_suppressions
+ORDER BY "created_at" DESC, "email"
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
-	)
-	return i, err
+func (q *Queries) ListEmailSuppressions(ctx context.Context) ([]EmailSuppression, error) {
+	rows, err := q.db.Query(ctx, listEmailSuppressions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailSuppression
+	for rows.Next() {
+		var i EmailSuppression
+		if err := rows.Scan(
+			&i.Email,
+			&i.Reason,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getParticipants = `-- name: GetParticipants :many
+const listParticipantsForTrips = `-- name: ListParticipantsForTrips :many
 SELECT "id",
     "trip_id",
     "email",
-    "is_confirmed"
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
 FROM participants
-WHERE "id" = $1
+WHERE "trip_id" = ANY($1::uuid[])
+ORDER BY "trip_id", "email", "id"
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, id uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, id)
+func (q *Queries) ListParticipantsForTrips(ctx context.Context, tripIds []uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, listParticipantsForTrips, tripIds)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +2141,10 @@ func (q *Queries) GetParticipants(ctx context.Context, id uuid.UUID) ([]Particip
 			&i.TripID,
 			&i.Email,
 			&i.IsConfirmed,
+			&i.Name,
+			&i.InviteMessage,
+			&i.ExpiresAt,
+			&i.PlusOnes,
 		); err != nil {
 			return nil, err
 		}
@@ -124,56 +2156,69 @@ func (q *Queries) GetParticipants(ctx context.Context, id uuid.UUID) ([]Particip
 	return items, nil
 }
 
-const getTrip = `-- name: GetTrip :one
+const listTrips = `-- name: ListTrips :many
 SELECT "id",
     "destination",
     "owner_email",
     "owner_name",
     "is_confirmed",
     "starts_at",
-    "ends_at"
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
 FROM trips
-WHERE "id" = $1
+WHERE (COALESCE("starts_at", 'infinity'), "id") > (
+        $1::timestamptz,
+        $2::uuid
+    )
+ORDER BY COALESCE("starts_at", 'infinity'),
+    "id"
+LIMIT $3
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
-	)
-	return i, err
+type ListTripsParams struct {
+	AfterStartsAt pgtype.Timestamptz
+	AfterID       uuid.UUID
+	Limit         int32
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT "id",
-    "trip_id",
-    "title",
-    "occurs_at"
-FROM activities
-WHERE "trip_id" = $1
-`
-
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+func (q *Queries) ListTrips(ctx context.Context, arg ListTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTrips, arg.AfterStartsAt, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Activity
+	var items []Trip
 	for rows.Next() {
-		var i Activity
+		var i Trip
 		if err := rows.Scan(
 			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsDraft,
+			&i.InviteMessage,
+			&i.ConfirmedAt,
+			&i.Slug,
+			&i.Version,
+			&i.RsvpDeadline,
+			&i.RsvpClosedAt,
+			&i.ReminderSentAt,
+			&i.ReplyTo,
+			&i.Timezone,
+			&i.IsPolling,
 		); err != nil {
 			return nil, err
 		}
@@ -185,33 +2230,260 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT "id",
-    "trip_id",
-    "title",
-    "url"
-FROM links
-WHERE "trip_id" = $1
+const markEmailDeadLetter = `-- name: MarkEmailDeadLetter :exec
+UPDATE email_outbox
+SET "status" = 'dead_letter',
+    "attempts" = "attempts" + 1,
+    "last_error" = $1
+WHERE "id" = $2
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type MarkEmailDeadLetterParams struct {
+	LastError pgtype.Text
+	ID        uuid.UUID
+}
+
+func (q *Queries) MarkEmailDeadLetter(ctx context.Context, arg MarkEmailDeadLetterParams) error {
+	_, err := q.db.Exec(ctx, markEmailDeadLetter, arg.LastError, arg.ID)
+	return err
+}
+
+const markEmailRetry = `-- name: MarkEmailRetry :exec
+UPDATE email_outbox
+SET "attempts" = "attempts" + 1,
+    "last_error" = $1,
+    "next_attempt_at" = now() + make_interval(secs => $2::float8)
+WHERE "id" = $3
+`
+
+type MarkEmailRetryParams struct {
+	LastError      pgtype.Text
+	BackoffSeconds float64
+	ID             uuid.UUID
+}
+
+func (q *Queries) MarkEmailRetry(ctx context.Context, arg MarkEmailRetryParams) error {
+	_, err := q.db.Exec(ctx, markEmailRetry, arg.LastError, arg.BackoffSeconds, arg.ID)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET "status" = 'sent',
+    "attempts" = "attempts" + 1,
+    "last_error" = NULL
+WHERE "id" = $1
+`
+
+func (q *Queries) MarkEmailSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markEmailSent, id)
+	return err
+}
+
+const publishTripIfDraft = `-- name: PublishTripIfDraft :execrows
+UPDATE trips
+SET "is_draft" = FALSE,
+    "version" = "version" + 1
+WHERE id = $1
+    AND "is_draft"
+`
+
+func (q *Queries) PublishTripIfDraft(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, publishTripIfDraft, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const queueDueActivityReminders = `-- name: QueueDueActivityReminders :execrows
+WITH due AS (
+    UPDATE activities
+    SET "reminder_sent_at" = now()
+    FROM trips
+    WHERE trips."id" = activities."trip_id"
+        AND trips."is_draft" = FALSE
+        AND activities."remind_before" IS NOT NULL
+        AND activities."reminder_sent_at" IS NULL
+        AND activities."occurs_at" > now()
+        AND activities."occurs_at" - make_interval(mins => activities."remind_before") <= now()
+    RETURNING activities."id",
+        activities."trip_id",
+        activities."remind_participants"
+)
+INSERT INTO email_outbox ("trip_id", "activity_id", "participant_id", "kind", "request_id")
+SELECT "trip_id",
+    "id",
+    NULL::uuid,
+    $1,
+    $2
+FROM due
+UNION ALL
+SELECT due."trip_id",
+    due."id",
+    participants."id",
+    $1,
+    $2
+FROM due
+    JOIN participants ON participants."trip_id" = due."trip_id"
+WHERE due."remind_participants"
+    AND participants."is_confirmed"
+`
+
+type QueueDueActivityRemindersParams struct {
+	Kind      string
+	RequestID pgtype.Text
+}
+
+func (q *Queries) QueueDueActivityReminders(ctx context.Context, arg QueueDueActivityRemindersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, queueDueActivityReminders, arg.Kind, arg.RequestID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recordTestEmail = `-- name: RecordTestEmail :one
+INSERT INTO email_outbox ("kind", "status", "attempts", "last_error", "request_id", "payload")
+VALUES ($1, $2, 1, $3, $4, $5)
+RETURNING "id"
+`
+
+type RecordTestEmailParams struct {
+	Kind      string
+	Status    string
+	LastError pgtype.Text
+	RequestID pgtype.Text
+	Payload   []byte
+}
+
+func (q *Queries) RecordTestEmail(ctx context.Context, arg RecordTestEmailParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, recordTestEmail,
+		arg.Kind,
+		arg.Status,
+		arg.LastError,
+		arg.RequestID,
+		arg.Payload,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const removeParticipant = `-- name: RemoveParticipant :execrows
+DELETE FROM participants
+WHERE "id" = $1
+    AND "trip_id" = $2
+`
+
+type RemoveParticipantParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) RemoveParticipant(ctx context.Context, arg RemoveParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeParticipant, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const requeueEmail = `-- name: RequeueEmail :execrows
+UPDATE email_outbox
+SET "status" = 'pending',
+    "attempts" = 0,
+    "next_attempt_at" = now()
+WHERE "id" = $1
+    AND "status" = 'dead_letter'
+`
+
+func (q *Queries) RequeueEmail(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, requeueEmail, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const selectTripDateOptionIfPolling = `-- name: SelectTripDateOptionIfPolling :one
+UPDATE trips
+SET "starts_at" = o."starts_at",
+    "ends_at" = o."ends_at",
+    "is_polling" = FALSE,
+    "version" = trips."version" + 1
+FROM trip_date_options o
+WHERE trips."id" = $1
+    AND o."id" = $2
+    AND o."trip_id" = trips."id"
+    AND trips."is_polling"
+RETURNING trips."is_confirmed",
+    trips."starts_at",
+    trips."ends_at"
+`
+
+type SelectTripDateOptionIfPollingParams struct {
+	TripID   uuid.UUID
+	OptionID uuid.UUID
+}
+
+type SelectTripDateOptionIfPollingRow struct {
+	IsConfirmed bool
+	StartsAt    pgtype.Timestamptz
+	EndsAt      pgtype.Timestamptz
+}
+
+func (q *Queries) SelectTripDateOptionIfPolling(ctx context.Context, arg SelectTripDateOptionIfPollingParams) (SelectTripDateOptionIfPollingRow, error) {
+	row := q.db.QueryRow(ctx, selectTripDateOptionIfPolling, arg.TripID, arg.OptionID)
+	var i SelectTripDateOptionIfPollingRow
+	err := row.Scan(&i.IsConfirmed, &i.StartsAt, &i.EndsAt)
+	return i, err
+}
+
+const setActivityPinned = `-- name: SetActivityPinned :many
+UPDATE activities
+SET "pinned" = $1
+WHERE "trip_id" = $2
+    AND (
+        "id" = $3
+        OR (
+            $4::bool
+            AND "recurrence_group" = (
+                SELECT "recurrence_group"
+                FROM activities
+                WHERE "id" = $3
+                    AND "trip_id" = $2
+            )
+        )
+    )
+RETURNING "id"
+`
+
+type SetActivityPinnedParams struct {
+	Pinned     bool
+	TripID     uuid.UUID
+	ID         uuid.UUID
+	WholeGroup bool
+}
+
+func (q *Queries) SetActivityPinned(ctx context.Context, arg SetActivityPinnedParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, setActivityPinned,
+		arg.Pinned,
+		arg.TripID,
+		arg.ID,
+		arg.WholeGroup,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []uuid.UUID
 	for rows.Next() {
-		var i Link
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.Url,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -219,86 +2491,227 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT INTO trips (
-        "destination",
-        "owner_email",
-        "owner_name",
-        "starts_at",
-        "ends_at"
-    )
-VALUES ($1, $2, $3, $4, $5)
-RETURNING "id"
+const setChecklistItemDone = `-- name: SetChecklistItemDone :execrows
+UPDATE checklist_items
+SET "done" = $1
+WHERE "id" = $2
+    AND "trip_id" = $3
 `
 
-type InsertTripParams struct {
-	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+type SetChecklistItemDoneParams struct {
+	Done   bool
+	ID     uuid.UUID
+	TripID uuid.UUID
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
+func (q *Queries) SetChecklistItemDone(ctx context.Context, arg SetChecklistItemDoneParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setChecklistItemDone, arg.Done, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const suppressEmail = `-- name: SuppressEmail :one
+INSERT INTO email_suppressions ("email", "reason", "note")
+VALUES ($1, $2, $3)
+ON CONFLICT ("email") DO UPDATE
+SET "reason" = EXCLUDED."reason",
+    "note" = EXCLUDED."note"
+RETURNING "email", "reason", "note", "created_at"
+`
+
+type SuppressEmailParams struct {
+	Email  string
+	Reason string
+	Note   pgtype.Text
+}
+
+func (q *Queries) SuppressEmail(ctx context.Context, arg SuppressEmailParams) (EmailSuppression, error) {
+	row := q.db.QueryRow(ctx, suppressEmail, arg.Email, arg.Reason, arg.Note)
+	var i EmailSuppression
+	err := row.Scan(
+		&i.Email,
+		&i.Reason,
+		&i.Note,
+		&i.CreatedAt,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	return i, err
 }
 
-const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
-INSERT INTO participants ("trip_id", "email")
+const unsubscribeEmail = `-- name: UnsubscribeEmail :exec
+INSERT INTO email_preferences ("email", "category")
 VALUES ($1, $2)
-RETURNING "id"
+ON CONFLICT ("email", "category") DO NOTHING
 `
 
-type InviteParticipantToTripParams struct {
-	TripID uuid.UUID
-	Email  string
+type UnsubscribeEmailParams struct {
+	Email    string
+	Category string
 }
 
-func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, inviteParticipantToTrip, arg.TripID, arg.Email)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) UnsubscribeEmail(ctx context.Context, arg UnsubscribeEmailParams) error {
+	_, err := q.db.Exec(ctx, unsubscribeEmail, arg.Email, arg.Category)
+	return err
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID
-	Email  string
+const updateParticipantEmail = `-- name: UpdateParticipantEmail :execrows
+UPDATE participants
+SET "email" = $1,
+    "is_confirmed" = FALSE,
+    "expires_at" = $2
+WHERE "id" = $3
+    AND NOT EXISTS (
+        SELECT 1
+        FROM participants other
+        WHERE other."trip_id" = participants."trip_id"
+            AND other."id" <> participants."id"
+            AND lower(other."email") = lower($1)
+    )
+`
+
+type UpdateParticipantEmailParams struct {
+	Email     string
+	ExpiresAt pgtype.Timestamp
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateParticipantEmail(ctx context.Context, arg UpdateParticipantEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateParticipantEmail, arg.Email, arg.ExpiresAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
+const updateTripIfVersion = `-- name: UpdateTripIfVersion :one
 UPDATE trips
 SET "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
-WHERE id = $5
+    "is_confirmed" = $4,
+    "invite_message" = $5,
+    "rsvp_deadline" = $6,
+    "reply_to" = $7,
+    "is_polling" = FALSE,
+    "version" = "version" + 1
+WHERE id = $8
+    AND "version" = $9
+RETURNING "version"
 `
 
-type UpdateTripParams struct {
-	Destination string
-	EndsAt      pgtype.Timestamp
-	StartsAt    pgtype.Timestamp
-	IsConfirmed bool
-	ID          uuid.UUID
+type UpdateTripIfVersionParams struct {
+	Destination   string
+	EndsAt        pgtype.Timestamptz
+	StartsAt      pgtype.Timestamptz
+	IsConfirmed   bool
+	InviteMessage pgtype.Text
+	RsvpDeadline  pgtype.Timestamptz
+	ReplyTo       pgtype.Text
+	ID            uuid.UUID
+	Version       int32
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
+func (q *Queries) UpdateTripIfVersion(ctx context.Context, arg UpdateTripIfVersionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateTripIfVersion,
 		arg.Destination,
 		arg.EndsAt,
 		arg.StartsAt,
 		arg.IsConfirmed,
+		arg.InviteMessage,
+		arg.RsvpDeadline,
+		arg.ReplyTo,
 		arg.ID,
+		arg.Version,
 	)
-	return err
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const updateTripLink = `-- name: UpdateTripLink :execrows
+UPDATE links
+SET "title" = $1,
+    "url" = $2
+WHERE "id" = $3
+    AND "trip_id" = $4
+`
+
+type UpdateTripLinkParams struct {
+	Title  string
+	Url    string
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) UpdateTripLink(ctx context.Context, arg UpdateTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripLink,
+		arg.Title,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const verifyOwnerEmailChange = `-- name: VerifyOwnerEmailChange :execrows
+WITH verified AS (
+    UPDATE owner_email_changes
+    SET "verified_at" = now()
+    WHERE "token" = $1
+        AND "trip_id" = $2
+        AND "verified_at" IS NULL
+        AND "expires_at" > now()
+    RETURNING "trip_id", "email"
+)
+UPDATE trips
+SET "owner_email" = verified."email",
+    "version" = trips."version" + 1
+FROM verified
+WHERE trips."id" = verified."trip_id"
+`
+
+type VerifyOwnerEmailChangeParams struct {
+	Token  string
+	TripID uuid.UUID
+}
+
+func (q *Queries) VerifyOwnerEmailChange(ctx context.Context, arg VerifyOwnerEmailChangeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, verifyOwnerEmailChange, arg.Token, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const voteTripDateOption = `-- name: VoteTripDateOption :execrows
+INSERT INTO trip_date_votes ("trip_id", "participant_id", "option_id")
+SELECT o."trip_id",
+    $1,
+    o."id"
+FROM trip_date_options o
+    JOIN trips t ON t."id" = o."trip_id"
+WHERE o."id" = $2
+    AND o."trip_id" = $3
+    AND t."is_polling"
+ON CONFLICT ("trip_id", "participant_id") DO UPDATE
+SET "option_id" = EXCLUDED."option_id",
+    "voted_at" = now()
+`
+
+type VoteTripDateOptionParams struct {
+	ParticipantID uuid.UUID
+	OptionID      uuid.UUID
+	TripID        uuid.UUID
+}
+
+func (q *Queries) VoteTripDateOption(ctx context.Context, arg VoteTripDateOptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, voteTripDateOption, arg.ParticipantID, arg.OptionID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
@@ -1,12 +1,20 @@
 -- name: InsertTrip :one
 INSERT INTO trips (
+        "id",
         "destination",
         "owner_email",
         "owner_name",
         "starts_at",
-        "ends_at"
+        "ends_at",
+        "is_draft",
+        "invite_message",
+        "slug",
+        "rsvp_deadline",
+        "reply_to",
+        "timezone",
+        "is_polling"
     )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING "id";
 
 -- name: GetTrip :one
@@ -16,78 +24,937 @@ SELECT "id",
     "owner_name",
     "is_confirmed",
     "starts_at",
-    "ends_at"
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
 FROM trips
 WHERE "id" = $1;
 
--- name: UpdateTrip :exec
+-- name: GetTripsByIDs :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "id" = ANY($1::uuid[]);
+
+-- name: ConfirmTripIfUnconfirmed :one
+UPDATE trips
+SET "is_confirmed" = TRUE,
+    "confirmed_at" = now(),
+    "version" = "version" + 1
+WHERE "id" = $1
+    AND "is_confirmed" = FALSE
+RETURNING "id";
+
+-- name: GetTripIDBySlug :one
+SELECT "id"
+FROM trips
+WHERE "slug" = $1;
+
+-- name: PublishTripIfDraft :execrows
+UPDATE trips
+SET "is_draft" = FALSE,
+    "version" = "version" + 1
+WHERE id = $1
+    AND "is_draft";
+
+-- name: UpdateTripIfVersion :one
 UPDATE trips
 SET "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
-WHERE id = $5;
+    "is_confirmed" = $4,
+    "invite_message" = $5,
+    "rsvp_deadline" = $6,
+    "reply_to" = $7,
+    "is_polling" = FALSE,
+    "version" = "version" + 1
+WHERE id = $8
+    AND "version" = $9
+RETURNING "version";
+
+-- name: GetTripVersion :one
+SELECT "version"
+FROM trips
+WHERE id = $1;
+
+-- name: GetOverlappingTrips :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "owner_email" = sqlc.arg(owner_email)
+    AND "is_draft" = FALSE
+    AND "ends_at" >= sqlc.arg(starts_at)
+    AND "starts_at" <= sqlc.arg(ends_at)
+ORDER BY "starts_at";
+
+-- name: GetTripsDueForReminder :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE "reminder_sent_at" IS NULL
+    AND "is_draft" = FALSE
+    AND "starts_at" > sqlc.arg(now)
+    AND "starts_at" <= sqlc.arg(now) + make_interval(secs => sqlc.arg(window_seconds)::float8)
+ORDER BY "starts_at";
+
+-- name: ListTrips :many
+SELECT "id",
+    "destination",
+    "owner_email",
+    "owner_name",
+    "is_confirmed",
+    "starts_at",
+    "ends_at",
+    "is_draft",
+    "invite_message",
+    "confirmed_at",
+    "slug",
+    "version",
+    "rsvp_deadline",
+    "rsvp_closed_at",
+    "reminder_sent_at",
+    "reply_to",
+    "timezone",
+    "is_polling"
+FROM trips
+WHERE (COALESCE("starts_at", 'infinity'), "id") > (
+        sqlc.arg('after_starts_at')::timestamptz,
+        sqlc.arg('after_id')::uuid
+    )
+ORDER BY COALESCE("starts_at", 'infinity'),
+    "id"
+LIMIT sqlc.arg('limit');
+
+-- name: GetGlobalStats :one
+SELECT (SELECT COUNT(*) FROM trips) AS trips,
+    (SELECT COUNT(*) FROM trips WHERE "is_confirmed") AS confirmed_trips,
+    (SELECT COUNT(*) FROM activities) AS activities,
+    (SELECT COUNT(*) FROM participants) AS participants;
 
 -- name: GetParticipant :one
 SELECT "id",
     "trip_id",
     "email",
-    "is_confirmed"
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
 FROM participants
 WHERE "id" = $1;
 
--- name: ConfirmParticipant :exec
+-- name: ConfirmParticipant :execrows
 UPDATE participants
-SET "is_confirmed" = TRUE
-WHERE id = $1;
+SET "is_confirmed" = TRUE,
+    "plus_ones" = COALESCE(sqlc.narg(plus_ones), "plus_ones")
+WHERE id = sqlc.arg(id)
+    AND "is_confirmed" = FALSE;
+
+-- name: ExtendParticipantInvite :exec
+UPDATE participants
+SET "expires_at" = $1
+WHERE id = $2;
 
 -- name: GetParticipants :many
 SELECT "id",
     "trip_id",
     "email",
-    "is_confirmed"
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
 FROM participants
 WHERE "id" = $1;
 
+-- name: ListParticipantsForTrips :many
+SELECT "id",
+    "trip_id",
+    "email",
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
+FROM participants
+WHERE "trip_id" = ANY(sqlc.arg(trip_ids)::uuid[])
+ORDER BY "trip_id", "email", "id";
+
+-- name: GetTripParticipants :many
+SELECT "id",
+    "trip_id",
+    "email",
+    "is_confirmed",
+    "name",
+    "invite_message",
+    "expires_at",
+    "plus_ones"
+FROM participants
+WHERE "trip_id" = sqlc.arg('trip_id')
+    AND (
+        sqlc.arg('query')::text = ''
+        OR unaccent("email") ILIKE unaccent('%' || sqlc.arg('query')::text || '%')
+        OR unaccent(COALESCE("name", '')) ILIKE unaccent('%' || sqlc.arg('query')::text || '%')
+    )
+    AND ("email", "id") > (sqlc.arg('after_email')::text, sqlc.arg('after_id')::uuid)
+ORDER BY "email", "id"
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: GetTripParticipantStats :one
+SELECT COUNT(*) AS invited,
+    COUNT(*) FILTER (WHERE "is_confirmed") AS confirmed,
+    COALESCE(SUM(1 + "plus_ones") FILTER (WHERE "is_confirmed"), 0)::bigint AS headcount
+FROM participants
+WHERE "trip_id" = $1;
+
 -- name: InviteParticipantToTrip :one
-INSERT INTO participants ("trip_id", "email")
-VALUES ($1, $2)
+INSERT INTO participants (
+        "id",
+        "trip_id",
+        "email",
+        "invite_message",
+        "expires_at",
+        "plus_ones"
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING "id";
 
 -- name: InviteParticipantsToTrip :copyfrom
-INSERT INTO participants ("trip_id", "email")
-VALUES ($1, $2);
+INSERT INTO participants ("id", "trip_id", "email", "expires_at")
+VALUES ($1, $2, $3, $4);
 
 -- name: CreateActivity :one
 INSERT INTO activities (
+        "id",
         "trip_id",
         "title",
-        "occurs_at"
+        "occurs_at",
+        "remind_before",
+        "remind_participants",
+        "recurrence_group",
+        "latitude",
+        "longitude",
+        "duration_minutes"
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING "id";
+
+-- name: SetActivityPinned :many
+UPDATE activities
+SET "pinned" = sqlc.arg(pinned)
+WHERE "trip_id" = sqlc.arg(trip_id)
+    AND (
+        "id" = sqlc.arg(id)
+        OR (
+            sqlc.arg(whole_group)::bool
+            AND "recurrence_group" = (
+                SELECT "recurrence_group"
+                FROM activities
+                WHERE "id" = sqlc.arg(id)
+                    AND "trip_id" = sqlc.arg(trip_id)
+            )
+        )
+    )
+RETURNING "id";
+
+-- name: DeleteActivities :many
+DELETE FROM activities
+WHERE "trip_id" = sqlc.arg(trip_id)
+    AND (
+        "id" = sqlc.arg(id)
+        OR (
+            sqlc.arg(whole_group)::bool
+            AND "recurrence_group" = (
+                SELECT "recurrence_group"
+                FROM activities
+                WHERE "id" = sqlc.arg(id)
+                    AND "trip_id" = sqlc.arg(trip_id)
+            )
+        )
     )
-VALUES ($1, $2, $3)
 RETURNING "id";
 
 -- name: GetTripActivities :many
 SELECT "id",
     "trip_id",
     "title",
-    "occurs_at"
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
 FROM activities
-WHERE "trip_id" = $1;
+WHERE "trip_id" = sqlc.arg('trip_id')
+    AND (
+        sqlc.arg('query')::text = ''
+        OR unaccent("title") ILIKE unaccent('%' || sqlc.arg('query')::text || '%')
+    )
+ORDER BY date_trunc('day', "occurs_at", (SELECT t."timezone" FROM trips t WHERE t."id" = activities."trip_id")), "pinned" DESC, "occurs_at", "id";
+
+-- name: GetTripActivitiesBetween :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = $1
+    AND "occurs_at" >= sqlc.arg('from')
+    AND "occurs_at" < sqlc.arg('to')
+ORDER BY "occurs_at", "pinned" DESC, "id";
+
+-- name: GetActivitiesForTrips :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = ANY(sqlc.arg(trip_ids)::uuid[])
+ORDER BY "trip_id", date_trunc('day', "occurs_at", (SELECT t."timezone" FROM trips t WHERE t."id" = activities."trip_id")), "pinned" DESC, "occurs_at", "id";
+
+-- name: GetDuplicateActivities :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "trip_id" = $1
+    AND ("title", "occurs_at") IN (
+        SELECT "title",
+            "occurs_at"
+        FROM activities
+        WHERE "trip_id" = $1
+        GROUP BY "title",
+            "occurs_at"
+        HAVING COUNT(*) > 1
+    )
+ORDER BY "occurs_at", "title", "created_at", "id";
+
+-- name: RemoveParticipant :execrows
+DELETE FROM participants
+WHERE "id" = $1
+    AND "trip_id" = $2;
+
+-- name: DeleteDuplicateActivities :many
+DELETE FROM activities
+WHERE "trip_id" = $1
+    AND "id" NOT IN (
+        SELECT DISTINCT ON ("title", "occurs_at") "id"
+        FROM activities
+        WHERE "trip_id" = $1
+        ORDER BY "title", "occurs_at", "created_at", "id"
+    )
+RETURNING "id";
+
+-- name: GetActivity :one
+SELECT "id",
+    "trip_id",
+    "title",
+    "occurs_at",
+    "remind_before",
+    "remind_participants",
+    "reminder_sent_at",
+    "created_at",
+    "pinned",
+    "recurrence_group",
+    "latitude",
+    "longitude",
+    "duration_minutes"
+FROM activities
+WHERE "id" = $1;
 
 -- name: CreateTripLink :one
 INSERT INTO links (
+        "id",
         "trip_id",
         "title",
         "url"
     )
-VALUES ($1, $2, $3)
+VALUES ($1, $2, $3, $4)
 RETURNING "id";
 
+-- name: CountTripLinks :one
+SELECT COUNT(*)
+FROM links
+WHERE "trip_id" = $1;
+
 -- name: GetTripLinks :many
 SELECT "id",
     "trip_id",
     "title",
     "url"
 FROM links
-WHERE "trip_id" = $1;
\ No newline at end of file
+WHERE "trip_id" = $1
+ORDER BY "id";
+
+-- name: GetTripLink :one
+SELECT "id",
+    "trip_id",
+    "title",
+    "url"
+FROM links
+WHERE "id" = $1
+    AND "trip_id" = $2;
+
+-- name: UpdateTripLink :execrows
+UPDATE links
+SET "title" = $1,
+    "url" = $2
+WHERE "id" = $3
+    AND "trip_id" = $4;
+
+-- name: GetTripActivityStats :one
+SELECT COUNT(*) AS total,
+    MIN("occurs_at")::timestamptz AS earliest_at,
+    MAX("occurs_at")::timestamptz AS latest_at
+FROM activities
+WHERE "trip_id" = $1;
+
+-- name: GetTripActivityCountsPerDay :many
+SELECT date_trunc('day', "occurs_at", 'UTC')::timestamptz AS day,
+    COUNT(*) AS total
+FROM activities
+WHERE "trip_id" = $1
+GROUP BY day
+ORDER BY day;
+
+-- name: EnqueueEmail :one
+INSERT INTO email_outbox ("trip_id", "kind", "request_id")
+VALUES ($1::uuid, $2, $3)
+RETURNING "id";
+
+-- name: EnqueueTripInvites :execrows
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id")
+SELECT "trip_id",
+    "id",
+    sqlc.arg(kind),
+    sqlc.arg(request_id)
+FROM participants
+WHERE "trip_id" = sqlc.arg(trip_id)
+    AND "is_confirmed" = FALSE;
+
+-- name: EnqueueTripUpdatedEmails :execrows
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id", "payload")
+SELECT "trip_id",
+    "id",
+    sqlc.arg(kind),
+    sqlc.arg(request_id),
+    sqlc.arg(payload)
+FROM participants
+WHERE "trip_id" = sqlc.arg(trip_id)
+    AND "is_confirmed" = TRUE;
+
+-- name: EnqueueParticipantEmail :one
+INSERT INTO email_outbox ("trip_id", "participant_id", "kind", "request_id")
+VALUES ($1::uuid, $2, $3, $4)
+RETURNING "id";
+
+-- name: CountRecentParticipantEmails :one
+SELECT COUNT(*)
+FROM email_outbox
+WHERE "participant_id" = $1
+    AND "kind" = $2
+    AND "created_at" > now() - make_interval(secs => sqlc.arg(window_seconds)::float8);
+
+-- name: ClaimDueEmails :many
+UPDATE email_outbox
+SET "next_attempt_at" = now() + make_interval(secs => sqlc.arg(lease_seconds)::float8)
+WHERE "id" IN (
+        SELECT "id"
+        FROM email_outbox
+        WHERE "status" = 'pending'
+            AND "next_attempt_at" <= now()
+        ORDER BY "next_attempt_at"
+        LIMIT sqlc.arg(batch_size)
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id",
+    "trip_id",
+    "kind",
+    "status",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+    "created_at",
+    "activity_id",
+    "participant_id",
+    "request_id",
+    "payload";
+
+-- name: DeferEmail :execrows
+UPDATE email_outbox
+SET "next_attempt_at" = "created_at" + make_interval(secs => sqlc.arg(delay_seconds)::float8)
+WHERE "id" = sqlc.arg(id)
+    AND "created_at" + make_interval(secs => sqlc.arg(delay_seconds)::float8) > now();
+
+-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET "status" = 'sent',
+    "attempts" = "attempts" + 1,
+    "last_error" = NULL
+WHERE "id" = $1;
+
+-- name: MarkEmailRetry :exec
+UPDATE email_outbox
+SET "attempts" = "attempts" + 1,
+    "last_error" = sqlc.arg(last_error),
+    "next_attempt_at" = now() + make_interval(secs => sqlc.arg(backoff_seconds)::float8)
+WHERE "id" = sqlc.arg(id);
+
+-- name: MarkEmailDeadLetter :exec
+UPDATE email_outbox
+SET "status" = 'dead_letter',
+    "attempts" = "attempts" + 1,
+    "last_error" = $1
+WHERE "id" = $2;
+
+-- name: RecordTestEmail :one
+INSERT INTO email_outbox ("kind", "status", "attempts", "last_error", "request_id", "payload")
+VALUES (sqlc.arg(kind), sqlc.arg(status), 1, sqlc.arg(last_error), sqlc.arg(request_id), sqlc.arg(payload))
+RETURNING "id";
+
+-- name: GetDeadLetterEmails :many
+SELECT "id",
+    "trip_id",
+    "kind",
+    "status",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+    "created_at",
+    "activity_id",
+    "participant_id",
+    "request_id",
+    "payload"
+FROM email_outbox
+WHERE "status" = 'dead_letter'
+ORDER BY "created_at";
+
+-- name: CountDeadLetterEmails :one
+SELECT COUNT(*)
+FROM email_outbox
+WHERE "status" = 'dead_letter';
+
+-- name: RequeueEmail :execrows
+UPDATE email_outbox
+SET "status" = 'pending',
+    "attempts" = 0,
+    "next_attempt_at" = now()
+WHERE "id" = $1
+    AND "status" = 'dead_letter';
+
+-- name: ListEmailSuppressions :many
+SELECT "email",
+    "reason",
+    "note",
+    "created_at"
+FROM email_suppressions
+ORDER BY "created_at" DESC, "email";
+
+-- name: GetSuppressedEmails :many
+SELECT "email"
+FROM email_suppressions
+WHERE "email" = ANY(sqlc.arg(emails)::text[]);
+
+-- name: SuppressEmail :one
+INSERT INTO email_suppressions ("email", "reason", "note")
+VALUES ($1, $2, $3)
+ON CONFLICT ("email") DO UPDATE
+SET "reason" = EXCLUDED."reason",
+    "note" = EXCLUDED."note"
+RETURNING "email", "reason", "note", "created_at";
+
+-- name: DeleteEmailSuppression :execrows
+DELETE FROM email_suppressions
+WHERE "email" = $1;
+
+-- name: UnsubscribeEmail :exec
+INSERT INTO email_preferences ("email", "category")
+VALUES ($1, $2)
+ON CONFLICT ("email", "category") DO NOTHING;
+
+-- name: GetUnsubscribedEmails :many
+SELECT "email"
+FROM email_preferences
+WHERE "category" = sqlc.arg(category)
+    AND "email" = ANY(sqlc.arg(emails)::text[]);
+
+-- name: GetParticipantActivities :many
+SELECT a."id",
+    a."trip_id",
+    a."title",
+    a."occurs_at",
+    a."pinned",
+    a."recurrence_group",
+    a."latitude",
+    a."longitude",
+    a."duration_minutes",
+    t."destination"
+FROM (
+        SELECT DISTINCT "trip_id"
+        FROM participants
+        WHERE "email" = sqlc.arg('email')
+            AND "is_confirmed"
+    ) p
+    JOIN activities a ON a."trip_id" = p."trip_id"
+    JOIN trips t ON t."id" = p."trip_id"
+WHERE (
+        sqlc.arg('include_past')::bool
+        OR a."occurs_at" >= now()
+    )
+    AND (a."occurs_at", a."id") > (
+        sqlc.arg('after_occurs_at')::timestamptz,
+        sqlc.arg('after_id')::uuid
+    )
+ORDER BY a."occurs_at", a."id"
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: QueueDueActivityReminders :execrows
+WITH due AS (
+    UPDATE activities
+    SET "reminder_sent_at" = now()
+    FROM trips
+    WHERE trips."id" = activities."trip_id"
+        AND trips."is_draft" = FALSE
+        AND activities."remind_before" IS NOT NULL
+        AND activities."reminder_sent_at" IS NULL
+        AND activities."occurs_at" > now()
+        AND activities."occurs_at" - make_interval(mins => activities."remind_before") <= now()
+    RETURNING activities."id",
+        activities."trip_id",
+        activities."remind_participants"
+)
+INSERT INTO email_outbox ("trip_id", "activity_id", "participant_id", "kind", "request_id")
+SELECT "trip_id",
+    "id",
+    NULL::uuid,
+    sqlc.arg(kind),
+    sqlc.arg(request_id)
+FROM due
+UNION ALL
+SELECT due."trip_id",
+    due."id",
+    participants."id",
+    sqlc.arg(kind),
+    sqlc.arg(request_id)
+FROM due
+    JOIN participants ON participants."trip_id" = due."trip_id"
+WHERE due."remind_participants"
+    AND participants."is_confirmed";
+
+-- name: CloseDueRSVPs :execrows
+WITH closed AS (
+    UPDATE trips
+    SET "rsvp_closed_at" = now()
+    WHERE "rsvp_deadline" <= now()
+        AND "rsvp_closed_at" IS NULL
+    RETURNING "id"
+),
+expired AS (
+    UPDATE participants
+    SET "expires_at" = now() AT TIME ZONE 'UTC'
+    FROM closed
+    WHERE participants."trip_id" = closed."id"
+        AND participants."is_confirmed" = FALSE
+        AND (
+            participants."expires_at" IS NULL
+            OR participants."expires_at" > now() AT TIME ZONE 'UTC'
+        )
+)
+INSERT INTO email_outbox ("trip_id", "kind", "request_id")
+SELECT "id",
+    sqlc.arg(kind),
+    sqlc.arg(request_id)
+FROM closed;
+
+-- name: HealthCheck :exec
+SELECT 1
+FROM trips
+LIMIT 1;
+
+-- name: CreateOwnerEmailChange :exec
+WITH superseded AS (
+    DELETE FROM owner_email_changes
+    WHERE "trip_id" = sqlc.arg(trip_id)
+        AND "verified_at" IS NULL
+)
+INSERT INTO owner_email_changes ("token", "trip_id", "email", "expires_at")
+VALUES (
+    sqlc.arg(token),
+    sqlc.arg(trip_id),
+    sqlc.arg(email),
+    now() + make_interval(secs => sqlc.arg(ttl_seconds)::float8)
+);
+
+-- name: VerifyOwnerEmailChange :execrows
+WITH verified AS (
+    UPDATE owner_email_changes
+    SET "verified_at" = now()
+    WHERE "token" = sqlc.arg(token)
+        AND "trip_id" = sqlc.arg(trip_id)
+        AND "verified_at" IS NULL
+        AND "expires_at" > now()
+    RETURNING "trip_id", "email"
+)
+UPDATE trips
+SET "owner_email" = verified."email",
+    "version" = trips."version" + 1
+FROM verified
+WHERE trips."id" = verified."trip_id";
+
+-- name: EnqueueEmailWithPayload :one
+INSERT INTO email_outbox ("trip_id", "kind", "request_id", "payload")
+VALUES ($1::uuid, $2, $3, $4)
+RETURNING "id";
+
+-- name: UpdateParticipantEmail :execrows
+UPDATE participants
+SET "email" = sqlc.arg(email),
+    "is_confirmed" = FALSE,
+    "expires_at" = sqlc.arg(expires_at)
+WHERE "id" = sqlc.arg(id)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM participants other
+        WHERE other."trip_id" = participants."trip_id"
+            AND other."id" <> participants."id"
+            AND lower(other."email") = lower(sqlc.arg(email))
+    );
+-- name: CreateExpense :execrows
+INSERT INTO expenses (
+        "id",
+        "trip_id",
+        "description",
+        "amount",
+        "currency",
+        "payer_id",
+        "activity_id"
+    )
+SELECT sqlc.arg(id)::uuid,
+    sqlc.arg(trip_id)::uuid,
+    sqlc.arg(description)::text,
+    sqlc.arg(amount)::bigint,
+    sqlc.arg(currency)::text,
+    sqlc.arg(payer_id)::uuid,
+    sqlc.narg(activity_id)::uuid
+WHERE NOT EXISTS (
+        SELECT 1
+        FROM expenses
+        WHERE "trip_id" = sqlc.arg(trip_id)::uuid
+            AND "currency" <> sqlc.arg(currency)::text
+    );
+
+-- name: GetTripExpenses :many
+SELECT "id",
+    "trip_id",
+    "description",
+    "amount",
+    "currency",
+    "payer_id",
+    "activity_id",
+    "created_at"
+FROM expenses
+WHERE "trip_id" = $1
+ORDER BY "created_at", "id";
+
+-- name: GetTripExpenseTotals :many
+SELECT "payer_id",
+    "currency",
+    SUM("amount")::bigint AS total
+FROM expenses
+WHERE "trip_id" = $1
+GROUP BY "payer_id", "currency"
+ORDER BY "payer_id";
+
+-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE "id" = $1
+    AND "trip_id" = $2;
+
+-- name: CreateChecklistItem :exec
+INSERT INTO checklist_items (
+        "id",
+        "trip_id",
+        "title",
+        "position",
+        "assignee_id",
+        "assigned_to_owner"
+    )
+VALUES (
+        sqlc.arg(id),
+        sqlc.arg(trip_id),
+        sqlc.arg(title),
+        (
+            SELECT COALESCE(MAX("position"), 0) + 1
+            FROM checklist_items
+            WHERE "trip_id" = sqlc.arg(trip_id)
+        ),
+        sqlc.narg(assignee_id),
+        sqlc.arg(assigned_to_owner)
+    );
+
+-- name: GetTripChecklist :many
+SELECT "id",
+    "trip_id",
+    "title",
+    "position",
+    "assignee_id",
+    "assigned_to_owner",
+    "done",
+    "created_at"
+FROM checklist_items
+WHERE "trip_id" = $1
+ORDER BY "position", "id";
+
+-- name: AssignChecklistItem :execrows
+UPDATE checklist_items
+SET "assignee_id" = $1,
+    "assigned_to_owner" = $2
+WHERE "id" = $3
+    AND "trip_id" = $4;
+
+-- name: SetChecklistItemDone :execrows
+UPDATE checklist_items
+SET "done" = $1
+WHERE "id" = $2
+    AND "trip_id" = $3;
+
+-- name: DeleteChecklistItem :execrows
+DELETE FROM checklist_items
+WHERE "id" = $1
+    AND "trip_id" = $2;
+
+-- name: InsertTripDateOption :exec
+INSERT INTO trip_date_options ("id", "trip_id", "starts_at", "ends_at")
+VALUES ($1, $2, $3, $4);
+
+-- name: GetTripDateOptionTallies :many
+SELECT o."id",
+    o."starts_at",
+    o."ends_at",
+    COUNT(v."participant_id") AS votes
+FROM trip_date_options o
+    LEFT JOIN trip_date_votes v ON v."option_id" = o."id"
+WHERE o."trip_id" = $1
+GROUP BY o."id"
+ORDER BY o."starts_at",
+    o."id";
+
+-- name: VoteTripDateOption :execrows
+INSERT INTO trip_date_votes ("trip_id", "participant_id", "option_id")
+SELECT o."trip_id",
+    sqlc.arg(participant_id),
+    o."id"
+FROM trip_date_options o
+    JOIN trips t ON t."id" = o."trip_id"
+WHERE o."id" = sqlc.arg(option_id)
+    AND o."trip_id" = sqlc.arg(trip_id)
+    AND t."is_polling"
+ON CONFLICT ("trip_id", "participant_id") DO UPDATE
+SET "option_id" = EXCLUDED."option_id",
+    "voted_at" = now();
+
+-- name: SelectTripDateOptionIfPolling :one
+UPDATE trips
+SET "starts_at" = o."starts_at",
+    "ends_at" = o."ends_at",
+    "is_polling" = FALSE,
+    "version" = trips."version" + 1
+FROM trip_date_options o
+WHERE trips."id" = sqlc.arg(trip_id)
+    AND o."id" = sqlc.arg(option_id)
+    AND o."trip_id" = trips."id"
+    AND trips."is_polling"
+RETURNING trips."is_confirmed",
+    trips."starts_at",
+    trips."ends_at";
\ No newline at end of file
@@ -0,0 +1,260 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryPolicy controls how idempotent reads are retried on transient errors.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first one.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled on every attempt.
+	Backoff time.Duration
+}
+
+// transientCodes are the Postgres error codes a read can safely be retried on,
+// mostly raised while the server restarts or fails over.
+var transientCodes = map[string]bool{
+	"08000": true, // connection_exception
+	"08001": true, // sqlclient_unable_to_establish_sqlconnection
+	"08003": true, // connection_does_not_exist
+	"08004": true, // sqlserver_rejected_establishment_of_sqlconnection
+	"08006": true, // connection_failure
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// IsTransient reports whether err is worth retrying.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return transientCodes[pgErr.Code]
+	}
+
+	return pgconn.SafeToRetry(err) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func retry[T any](ctx context.Context, policy RetryPolicy, fn func(context.Context) (T, error)) (T, error) {
+	delay := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		result, err := fn(ctx)
+		if err == nil || attempt >= policy.MaxAttempts || !IsTransient(err) {
+			return result, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// RetryingQueries wraps Queries retrying the idempotent reads on transient
+// errors. Writes are passed straight through.
+type RetryingQueries struct {
+	*Queries
+	policy RetryPolicy
+}
+
+func NewRetrying(db DBTX, policy RetryPolicy) *RetryingQueries {
+	return &RetryingQueries{New(db), policy}
+}
+
+func (q *RetryingQueries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (Trip, error) {
+		return q.Queries.GetTrip(ctx, id)
+	})
+}
+
+func (q *RetryingQueries) GetTripsByIDs(ctx context.Context, ids []uuid.UUID) ([]Trip, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Trip, error) {
+		return q.Queries.GetTripsByIDs(ctx, ids)
+	})
+}
+
+func (q *RetryingQueries) GetTripIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (uuid.UUID, error) {
+		return q.Queries.GetTripIDBySlug(ctx, slug)
+	})
+}
+
+func (q *RetryingQueries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (Participant, error) {
+		return q.Queries.GetParticipant(ctx, id)
+	})
+}
+
+func (q *RetryingQueries) GetParticipants(ctx context.Context, id uuid.UUID) ([]Participant, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Participant, error) {
+		return q.Queries.GetParticipants(ctx, id)
+	})
+}
+
+func (q *RetryingQueries) ListParticipantsForTrips(ctx context.Context, tripIds []uuid.UUID) ([]Participant, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Participant, error) {
+		return q.Queries.ListParticipantsForTrips(ctx, tripIds)
+	})
+}
+
+func (q *RetryingQueries) GetSuppressedEmails(ctx context.Context, emails []string) ([]string, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]string, error) {
+		return q.Queries.GetSuppressedEmails(ctx, emails)
+	})
+}
+
+func (q *RetryingQueries) GetUnsubscribedEmails(ctx context.Context, arg GetUnsubscribedEmailsParams) ([]string, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]string, error) {
+		return q.Queries.GetUnsubscribedEmails(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetActivity(ctx context.Context, id uuid.UUID) (Activity, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (Activity, error) {
+		return q.Queries.GetActivity(ctx, id)
+	})
+}
+
+func (q *RetryingQueries) GetActivitiesForTrips(ctx context.Context, tripIds []uuid.UUID) ([]Activity, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Activity, error) {
+		return q.Queries.GetActivitiesForTrips(ctx, tripIds)
+	})
+}
+
+func (q *RetryingQueries) GetDuplicateActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Activity, error) {
+		return q.Queries.GetDuplicateActivities(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripActivities(ctx context.Context, arg GetTripActivitiesParams) ([]Activity, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Activity, error) {
+		return q.Queries.GetTripActivities(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetParticipantActivities(ctx context.Context, arg GetParticipantActivitiesParams) ([]GetParticipantActivitiesRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]GetParticipantActivitiesRow, error) {
+		return q.Queries.GetParticipantActivities(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Link, error) {
+		return q.Queries.GetTripLinks(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) CountTripLinks(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (int64, error) {
+		return q.Queries.CountTripLinks(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) CountRecentParticipantEmails(ctx context.Context, arg CountRecentParticipantEmailsParams) (int64, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (int64, error) {
+		return q.Queries.CountRecentParticipantEmails(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripLink(ctx context.Context, arg GetTripLinkParams) (Link, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (Link, error) {
+		return q.Queries.GetTripLink(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripChecklist(ctx context.Context, tripID uuid.UUID) ([]ChecklistItem, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]ChecklistItem, error) {
+		return q.Queries.GetTripChecklist(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]Expense, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Expense, error) {
+		return q.Queries.GetTripExpenses(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]GetTripExpenseTotalsRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]GetTripExpenseTotalsRow, error) {
+		return q.Queries.GetTripExpenseTotals(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripDateOptionTallies(ctx context.Context, tripID uuid.UUID) ([]GetTripDateOptionTalliesRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]GetTripDateOptionTalliesRow, error) {
+		return q.Queries.GetTripDateOptionTallies(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripParticipants(ctx context.Context, arg GetTripParticipantsParams) ([]Participant, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Participant, error) {
+		return q.Queries.GetTripParticipants(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (GetTripParticipantStatsRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (GetTripParticipantStatsRow, error) {
+		return q.Queries.GetTripParticipantStats(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripActivityStats(ctx context.Context, tripID uuid.UUID) (GetTripActivityStatsRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (GetTripActivityStatsRow, error) {
+		return q.Queries.GetTripActivityStats(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetTripActivityCountsPerDay(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityCountsPerDayRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]GetTripActivityCountsPerDayRow, error) {
+		return q.Queries.GetTripActivityCountsPerDay(ctx, tripID)
+	})
+}
+
+func (q *RetryingQueries) GetOverlappingTrips(ctx context.Context, arg GetOverlappingTripsParams) ([]Trip, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Trip, error) {
+		return q.Queries.GetOverlappingTrips(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripsDueForReminder(ctx context.Context, arg GetTripsDueForReminderParams) ([]Trip, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Trip, error) {
+		return q.Queries.GetTripsDueForReminder(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetGlobalStats(ctx context.Context) (GetGlobalStatsRow, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) (GetGlobalStatsRow, error) {
+		return q.Queries.GetGlobalStats(ctx)
+	})
+}
+
+func (q *RetryingQueries) ListTrips(ctx context.Context, arg ListTripsParams) ([]Trip, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Trip, error) {
+		return q.Queries.ListTrips(ctx, arg)
+	})
+}
+
+func (q *RetryingQueries) GetTripActivitiesBetween(ctx context.Context, arg GetTripActivitiesBetweenParams) ([]Activity, error) {
+	return retry(ctx, q.policy, func(ctx context.Context) ([]Activity, error) {
+		return q.Queries.GetTripActivitiesBetween(ctx, arg)
+	})
+}
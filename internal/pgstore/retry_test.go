@@ -0,0 +1,117 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"no rows", pgx.ErrNoRows, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"undefined table", &pgconn.PgError{Code: "42P01"}, false},
+		{"query canceled", &pgconn.PgError{Code: "57014"}, false},
+		{"wrapped serialization failure", fmt.Errorf("get trip: %w", &pgconn.PgError{Code: "40001"}), true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"connection refused", syscall.ECONNREFUSED, true},
+		{"unexpected eof", io.ErrUnexpectedEOF, true},
+	}
+	for code := range transientCodes {
+		tests = append(tests, struct {
+			name string
+			err  error
+			want bool
+		}{"sqlstate " + code, &pgconn.PgError{Code: code}, true})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Errorf("IsTransient(%v) = %t, want %t", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	transient := &pgconn.PgError{Code: "57P01"}
+	permanent := &pgconn.PgError{Code: "23505"}
+
+	tests := []struct {
+		name        string
+		maxAttempts int
+		// errs are the results of the tries in order, the later tries
+		// succeed.
+		errs     []error
+		wantErr  error
+		attempts int
+	}{
+		{"first try", 3, nil, nil, 1},
+		{"recovers", 3, []error{transient, transient}, nil, 3},
+		{"gives up after max attempts", 3, []error{transient, transient, transient, transient}, transient, 3},
+		{"single attempt", 1, []error{transient}, transient, 1},
+		{"not transient", 3, []error{permanent}, permanent, 1},
+		{"no rows", 3, []error{pgx.ErrNoRows}, pgx.ErrNoRows, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			got, err := retry(context.Background(), RetryPolicy{MaxAttempts: tt.maxAttempts, Backoff: time.Millisecond}, func(context.Context) (int, error) {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return 0, tt.errs[attempts-1]
+				}
+				return 42, nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != 42 {
+				t.Errorf("result %d, want 42", got)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("%d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	transient := &pgconn.PgError{Code: "08006"}
+
+	attempts := 0
+	start := time.Now()
+	_, err := retry(ctx, RetryPolicy{MaxAttempts: 5, Backoff: time.Hour}, func(context.Context) (int, error) {
+		attempts++
+		cancel()
+		return 0, transient
+	})
+
+	if !errors.Is(err, transient) {
+		t.Errorf("error %v, want the last failure", err)
+	}
+	if attempts != 1 {
+		t.Errorf("%d attempts after the context was canceled, want 1", attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %s for the backoff of a canceled context", elapsed)
+	}
+}
@@ -0,0 +1,86 @@
+package pgstore
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// uniqueViolationCode is raised by Postgres when an insert breaks a
+	// unique index.
+	uniqueViolationCode = "23505"
+	slugIndex           = "trips_slug_idx"
+	// slugAlphabet leaves out characters that are easy to mishear or misread,
+	// like 0 and o or 1 and l.
+	slugAlphabet    = "23456789abcdefghjkmnpqrstuvwxyz"
+	slugSuffixLen   = 6
+	slugPrefixLen   = 40
+	slugMaxAttempts = 5
+)
+
+// NewSlug derives a short, readable trip identifier from the destination,
+// like "rio-de-janeiro-x7k2mq". The random suffix keeps it unique enough that
+// collisions are rare, CreateTrip retries the few that happen.
+func NewSlug(destination string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFD.String(strings.ToLower(destination)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Accents are dropped, so "São Paulo" becomes "sao-paulo".
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+		default:
+			dash = true
+		}
+	}
+
+	// Only ASCII is left, so cutting bytes can't split a character.
+	prefix := b.String()
+	if len(prefix) > slugPrefixLen {
+		prefix = strings.TrimRight(prefix[:slugPrefixLen], "-")
+	}
+	if prefix == "" {
+		return slugSuffix()
+	}
+	return prefix + "-" + slugSuffix()
+}
+
+func slugSuffix() string {
+	buf := make([]byte, slugSuffixLen)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	for i, v := range buf {
+		buf[i] = slugAlphabet[int(v)%len(slugAlphabet)]
+	}
+	return string(buf)
+}
+
+// IsSlug reports whether s could be a trip slug, so lookups can skip values
+// that never will be.
+func IsSlug(s string) bool {
+	if s == "" || len(s) > slugPrefixLen+1+slugSuffixLen {
+		return false
+	}
+	for _, r := range s {
+		if r != '-' && (r > unicode.MaxASCII || !(unicode.IsLower(r) || unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return true
+}
+
+func isSlugConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == slugIndex
+}
@@ -0,0 +1,59 @@
+package pgstore
+
+import (
+	"context"
+	"time"
+
+	"journey/internal/api/spec"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Store is the CachedQueries the API runs on, bound to the pool its
+// transactions begin on so the handlers never need the pool themselves.
+type Store struct {
+	*CachedQueries
+	pool *pgxpool.Pool
+}
+
+func NewStore(pool *pgxpool.Pool, policy RetryPolicy, trips *TripCache) *Store {
+	return &Store{NewCached(NewRetrying(pool, policy), trips), pool}
+}
+
+// Ping checks a connection can be acquired.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.pool.Ping(ctx)
+}
+
+func (s *Store) CreateTrip(ctx context.Context, params spec.CreateTripRequest, inviteTTL time.Duration) (uuid.UUID, error) {
+	return s.CachedQueries.CreateTrip(ctx, s.pool, params, inviteTTL)
+}
+
+func (s *Store) ChangeParticipantEmail(ctx context.Context, tripID uuid.UUID, arg UpdateParticipantEmailParams, invite bool) (bool, error) {
+	return s.CachedQueries.ChangeParticipantEmail(ctx, s.pool, tripID, arg, invite)
+}
+
+func (s *Store) ConfirmTrip(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	return s.CachedQueries.ConfirmTrip(ctx, s.pool, tripID)
+}
+
+func (s *Store) PublishTrip(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	return s.CachedQueries.PublishTrip(ctx, s.pool, tripID)
+}
+
+func (s *Store) RequestOwnerEmailChange(ctx context.Context, trip Trip, email string, ttl time.Duration) error {
+	return s.CachedQueries.RequestOwnerEmailChange(ctx, s.pool, trip, email, ttl)
+}
+
+func (s *Store) UpdateTrip(ctx context.Context, arg UpdateTripIfVersionParams, notify bool) (int32, error) {
+	return s.CachedQueries.UpdateTrip(ctx, s.pool, arg, notify)
+}
+
+func (s *Store) SelectTripDateOption(ctx context.Context, tripID, optionID uuid.UUID) (bool, error) {
+	return s.CachedQueries.SelectTripDateOption(ctx, s.pool, tripID, optionID)
+}
+
+func (s *Store) CreateActivities(ctx context.Context, activities []CreateActivityParams) ([]uuid.UUID, error) {
+	return s.CachedQueries.CreateActivities(ctx, s.pool, activities)
+}
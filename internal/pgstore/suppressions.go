@@ -0,0 +1,22 @@
+package pgstore
+
+import "strings"
+
+// Reasons an address is in the email_suppressions table.
+const (
+	// SuppressionBounce is set by the mailer when the server rejects the
+	// recipient for good.
+	SuppressionBounce = "bounce"
+	// SuppressionComplaint is for recipients who reported the mail as spam.
+	SuppressionComplaint = "complaint"
+	// SuppressionManual is for addresses an operator added, like a recipient
+	// asking to stop getting mail.
+	SuppressionManual = "manual"
+)
+
+// SuppressionKey is the key of an address in the email_suppressions table.
+// Unlike the participant e-mails the local part is lowercased too, a
+// mailbox that bounced under one spelling bounces under the others.
+func SuppressionKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
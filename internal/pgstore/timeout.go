@@ -0,0 +1,30 @@
+package pgstore
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// queryCanceledCode is raised by Postgres when statement_timeout cancels a
+// running statement.
+const queryCanceledCode = "57014"
+
+// WithStatementTimeout makes every connection of the pool cancel statements
+// running longer than timeout. A zero timeout leaves the server default.
+func WithStatementTimeout(cfg *pgxpool.Config, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	cfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+}
+
+// IsStatementTimeout reports whether err comes from a statement cancelled by
+// the server side statement_timeout.
+func IsStatementTimeout(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == queryCanceledCode
+}
@@ -0,0 +1,62 @@
+package pgstore
+
+import (
+	"context"
+	"journey/internal/logctx"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	at  time.Time
+	sql string
+}
+
+// QueryTracer logs statements slower than a threshold and the ones cancelled
+// by the statement timeout, naming them after their sqlc query. It logs with
+// the logger of the query context when there is one, so the lines carry the
+// request or job that ran the query.
+type QueryTracer struct {
+	logger *zap.Logger
+	slow   time.Duration
+}
+
+func NewQueryTracer(logger *zap.Logger, slow time.Duration) *QueryTracer {
+	return &QueryTracer{logger, slow}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{time.Now(), data.SQL})
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(start.at)
+	logger := logctx.From(ctx, t.logger)
+
+	switch {
+	case IsStatementTimeout(data.Err):
+		logger.Warn("statement timeout", zap.String("query", queryName(start.sql)), zap.Duration("elapsed", elapsed))
+	case t.slow > 0 && elapsed >= t.slow:
+		logger.Warn("slow query", zap.String("query", queryName(start.sql)), zap.Duration("elapsed", elapsed))
+	}
+}
+
+// queryName extracts the sqlc query name from the "-- name:" header, falling
+// back to the statement itself for ad hoc SQL.
+func queryName(sql string) string {
+	const prefix = "-- name: "
+	if rest, ok := strings.CutPrefix(sql, prefix); ok {
+		name, _, _ := strings.Cut(rest, " ")
+		return name
+	}
+	return sql
+}
@@ -2,50 +2,301 @@ package pgstore
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"journey/internal/api/spec"
+	"time"
 )
 
-func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
-	tx, err := pool.Begin(ctx)
-	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin trx for CreateTrip: %w", err)
-	}
-
-	defer tx.Rollback(ctx)
+// CreateTrip inserts the trip and its invites, which expire after inviteTTL
+// or when the trip starts.
+func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest, inviteTTL time.Duration) (uuid.UUID, error) {
+	var tripID uuid.UUID
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		tripID, err = q.CreateTripTx(ctx, tx, params, inviteTTL)
+		return err
+	})
+	return tripID, err
+}
 
+// CreateTripTx is CreateTrip on the caller's transaction.
+func (q *Queries) CreateTripTx(ctx context.Context, tx pgx.Tx, params spec.CreateTripRequest, inviteTTL time.Duration) (uuid.UUID, error) {
 	qtx := q.WithTx(tx)
 
-	tripID, err := qtx.InsertTrip(ctx, InsertTripParams{
-		Destination: params.Destination,
-		OwnerEmail:  string(params.OwnerEmail),
-		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+	tripID, err := q.insertTripWithSlug(ctx, tx, InsertTripParams{
+		ID:            NewID(),
+		Destination:   params.Destination,
+		OwnerEmail:    string(params.OwnerEmail),
+		OwnerName:     params.OwnerName,
+		StartsAt:      pgtype.Timestamptz{Valid: !params.StartsAt.IsZero(), Time: params.StartsAt},
+		EndsAt:        pgtype.Timestamptz{Valid: !params.EndsAt.IsZero(), Time: params.EndsAt},
+		IsDraft:       params.Draft,
+		InviteMessage: pgtype.Text{Valid: params.InviteMessage != "", String: params.InviteMessage},
+		RsvpDeadline:  pgtype.Timestamptz{Valid: !params.RsvpDeadline.IsZero(), Time: params.RsvpDeadline},
+		ReplyTo:       pgtype.Text{Valid: params.ReplyTo != "", String: string(params.ReplyTo)},
+		Timezone:      params.Timezone,
+		IsPolling:     len(params.DateOptions) > 0,
 	})
 
 	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateTrip: %w", err)
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
 	}
 
+	for _, option := range params.DateOptions {
+		if err := qtx.InsertTripDateOption(ctx, InsertTripDateOptionParams{
+			ID:       NewID(),
+			TripID:   tripID,
+			StartsAt: pgtype.Timestamptz{Valid: true, Time: option.StartsAt},
+			EndsAt:   pgtype.Timestamptz{Valid: true, Time: option.EndsAt},
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert date option for CreateTrip: %w", err)
+		}
+	}
+
+	expiresAt := InviteExpiry(pgtype.Timestamptz{Valid: !params.StartsAt.IsZero(), Time: params.StartsAt}, inviteTTL)
 	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
 	for i, eti := range params.EmailsToInvite {
 		participants[i] = InviteParticipantsToTripParams{
-			TripID: tripID,
-			Email:  string(eti),
+			ID:        NewID(),
+			TripID:    tripID,
+			Email:     string(eti),
+			ExpiresAt: expiresAt,
 		}
 	}
 
-	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateTrip: %w", err)
+	if err := q.insertParticipants(ctx, tx, participants); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert participants for CreateTrip: %w", err)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateTrip: %w", err)
+	// Queued in the same transaction so a created trip always gets its
+	// confirmation e-mail. Drafts are queued when published.
+	if !params.Draft {
+		if _, err := qtx.EnqueueEmail(ctx, EnqueueEmailParams{
+			TripID:    tripID,
+			Kind:      EmailKindConfirmTripOwner,
+			RequestID: RequestID(ctx),
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to enqueue email for CreateTrip: %w", err)
+		}
 	}
 
 	return tripID, nil
 }
+
+// insertTripWithSlug inserts the trip under a fresh slug, drawing a new one
+// when it is already taken. Each attempt runs in a savepoint so a conflict
+// doesn't abort the surrounding transaction.
+func (q *Queries) insertTripWithSlug(ctx context.Context, tx pgx.Tx, arg InsertTripParams) (uuid.UUID, error) {
+	for attempt := 1; ; attempt++ {
+		arg.Slug = NewSlug(arg.Destination)
+
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return uuid.UUID{}, err
+		}
+
+		tripID, err := q.WithTx(savepoint).InsertTrip(ctx, arg)
+		if err == nil {
+			return tripID, savepoint.Commit(ctx)
+		}
+		_ = savepoint.Rollback(ctx)
+
+		if !isSlugConflict(err) || attempt == slugMaxAttempts {
+			return uuid.UUID{}, err
+		}
+	}
+}
+
+// ConfirmTrip confirms the trip and queues an invite for every participant
+// still unconfirmed. Only the call that flips the flag queues them, so
+// concurrent confirms report false instead of inviting twice.
+func (q *Queries) ConfirmTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (bool, error) {
+	var confirmed bool
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		confirmed, err = q.ConfirmTripTx(ctx, tx, tripID)
+		return err
+	})
+	return confirmed, err
+}
+
+// ConfirmTripTx is ConfirmTrip on the caller's transaction.
+func (q *Queries) ConfirmTripTx(ctx context.Context, tx pgx.Tx, tripID uuid.UUID) (bool, error) {
+	qtx := q.WithTx(tx)
+
+	if _, err := qtx.ConfirmTripIfUnconfirmed(ctx, tripID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("pgstore: failed to confirm trip for ConfirmTrip: %w", err)
+	}
+
+	if _, err := qtx.EnqueueTripInvites(ctx, EnqueueTripInvitesParams{
+		Kind:      EmailKindParticipantInvite,
+		RequestID: RequestID(ctx),
+		TripID:    tripID,
+	}); err != nil {
+		return false, fmt.Errorf("pgstore: failed to enqueue invites for ConfirmTrip: %w", err)
+	}
+
+	return true, nil
+}
+
+// PublishTrip publishes the draft trip and queues the owner confirmation in
+// the same transaction. Only the call that clears the draft flag queues it,
+// so concurrent publishes report false instead of mailing the owner twice.
+func (q *Queries) PublishTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (bool, error) {
+	var published bool
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		published, err = q.PublishTripTx(ctx, tx, tripID)
+		return err
+	})
+	return published, err
+}
+
+// PublishTripTx is PublishTrip on the caller's transaction.
+func (q *Queries) PublishTripTx(ctx context.Context, tx pgx.Tx, tripID uuid.UUID) (bool, error) {
+	qtx := q.WithTx(tx)
+
+	published, err := qtx.PublishTripIfDraft(ctx, tripID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to publish trip for PublishTrip: %w", err)
+	}
+	if published == 0 {
+		return false, nil
+	}
+
+	if _, err := qtx.EnqueueEmail(ctx, EnqueueEmailParams{
+		TripID:    tripID,
+		Kind:      EmailKindConfirmTripOwner,
+		RequestID: RequestID(ctx),
+	}); err != nil {
+		return false, fmt.Errorf("pgstore: failed to enqueue confirmation for PublishTrip: %w", err)
+	}
+
+	return true, nil
+}
+
+// ChangeParticipantEmail moves the participant to a new address, back to
+// pending, and with invite set queues the invite to it in the same
+// transaction. It reports false, changing nothing, when another participant
+// of the trip already has the address.
+func (q *Queries) ChangeParticipantEmail(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, arg UpdateParticipantEmailParams, invite bool) (bool, error) {
+	var changed bool
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		changed, err = q.ChangeParticipantEmailTx(ctx, tx, tripID, arg, invite)
+		return err
+	})
+	return changed, err
+}
+
+// ChangeParticipantEmailTx is ChangeParticipantEmail on the caller's
+// transaction.
+func (q *Queries) ChangeParticipantEmailTx(ctx context.Context, tx pgx.Tx, tripID uuid.UUID, arg UpdateParticipantEmailParams, invite bool) (bool, error) {
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.UpdateParticipantEmail(ctx, arg)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to update participant for ChangeParticipantEmail: %w", err)
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	if invite {
+		if _, err := qtx.EnqueueParticipantEmail(ctx, EnqueueParticipantEmailParams{
+			TripID:        tripID,
+			ParticipantID: pgtype.UUID{Bytes: arg.ID, Valid: true},
+			Kind:          EmailKindParticipantInvite,
+			RequestID:     RequestID(ctx),
+		}); err != nil {
+			return false, fmt.Errorf("pgstore: failed to enqueue invite for ChangeParticipantEmail: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// CreateActivities inserts the occurrences of a recurring activity, all or
+// none of them, and returns their IDs in the same order.
+func (q *Queries) CreateActivities(ctx context.Context, pool *pgxpool.Pool, activities []CreateActivityParams) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		ids, err = q.CreateActivitiesTx(ctx, tx, activities)
+		return err
+	})
+	return ids, err
+}
+
+// CreateActivitiesTx is CreateActivities on the caller's transaction.
+func (q *Queries) CreateActivitiesTx(ctx context.Context, tx pgx.Tx, activities []CreateActivityParams) ([]uuid.UUID, error) {
+	qtx := q.WithTx(tx)
+
+	ids := make([]uuid.UUID, 0, len(activities))
+	for _, activity := range activities {
+		id, err := qtx.CreateActivity(ctx, activity)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to insert activity for CreateActivities: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// SelectTripDateOption ends the date poll of the trip, moving the option into
+// its dates. It reports false, changing nothing, when the trip isn't polling
+// or the option isn't one of its own. Confirmed participants of a confirmed
+// trip are queued a trip updated e-mail with the new dates.
+func (q *Queries) SelectTripDateOption(ctx context.Context, pool *pgxpool.Pool, tripID, optionID uuid.UUID) (bool, error) {
+	var selected bool
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		selected, err = q.SelectTripDateOptionTx(ctx, tx, tripID, optionID)
+		return err
+	})
+	return selected, err
+}
+
+// SelectTripDateOptionTx is SelectTripDateOption on the caller's transaction.
+func (q *Queries) SelectTripDateOptionTx(ctx context.Context, tx pgx.Tx, tripID, optionID uuid.UUID) (bool, error) {
+	qtx := q.WithTx(tx)
+
+	row, err := qtx.SelectTripDateOptionIfPolling(ctx, SelectTripDateOptionIfPollingParams{TripID: tripID, OptionID: optionID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("pgstore: failed to update trip for SelectTripDateOption: %w", err)
+	}
+
+	if row.IsConfirmed {
+		payload, err := json.Marshal([]TripChange{
+			{"starts_at", "", formatChange(row.StartsAt)},
+			{"ends_at", "", formatChange(row.EndsAt)},
+		})
+		if err != nil {
+			return false, fmt.Errorf("pgstore: failed to encode changes for SelectTripDateOption: %w", err)
+		}
+
+		if _, err := qtx.EnqueueTripUpdatedEmails(ctx, EnqueueTripUpdatedEmailsParams{
+			Kind:      EmailKindTripUpdated,
+			RequestID: RequestID(ctx),
+			Payload:   payload,
+			TripID:    tripID,
+		}); err != nil {
+			return false, fmt.Errorf("pgstore: failed to enqueue emails for SelectTripDateOption: %w", err)
+		}
+	}
+
+	return true, nil
+}
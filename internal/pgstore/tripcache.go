@@ -0,0 +1,161 @@
+package pgstore
+
+import (
+	"container/list"
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/sync/singleflight"
+)
+
+var tripCacheStats = expvar.NewMap("trip_cache")
+
+// TripCache keeps recently read trips in memory for a short while, collapsing
+// concurrent loads of the same trip into one query. A nil *TripCache is valid
+// and disables caching, which is what multi-replica deployments should use
+// since invalidation is local to the process.
+type TripCache struct {
+	ttl  time.Duration
+	size int
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]*list.Element
+	order   *list.List
+	// gen is bumped on every invalidation so loads that started before it
+	// don't put stale trips back in the cache.
+	gen   uint64
+	group singleflight.Group
+}
+
+type tripCacheEntry struct {
+	id      uuid.UUID
+	trip    Trip
+	expires time.Time
+}
+
+// NewTripCache returns a cache holding up to size trips for ttl each, or nil
+// when either is zero.
+func NewTripCache(ttl time.Duration, size int) *TripCache {
+	if ttl <= 0 || size <= 0 {
+		return nil
+	}
+	return &TripCache{ttl: ttl, size: size, entries: make(map[uuid.UUID]*list.Element), order: list.New()}
+}
+
+// Get returns the cached trip or loads it, sharing a single load between
+// concurrent callers asking for the same trip.
+func (c *TripCache) Get(ctx context.Context, id uuid.UUID, load func(context.Context, uuid.UUID) (Trip, error)) (Trip, error) {
+	if c == nil {
+		return load(ctx, id)
+	}
+
+	c.mu.Lock()
+	if el, ok := c.entries[id]; ok {
+		entry := el.Value.(*tripCacheEntry)
+		if time.Now().Before(entry.expires) {
+			c.order.MoveToFront(el)
+			c.mu.Unlock()
+			tripCacheStats.Add("hits", 1)
+			return entry.trip, nil
+		}
+		c.remove(el)
+	}
+	gen := c.gen
+	c.mu.Unlock()
+	tripCacheStats.Add("misses", 1)
+
+	// The shared load outlives any single caller, the statement timeout still
+	// bounds it.
+	v, err, _ := c.group.Do(id.String(), func() (any, error) {
+		return load(context.WithoutCancel(ctx), id)
+	})
+	if err != nil {
+		return Trip{}, err
+	}
+
+	trip := v.(Trip)
+	c.put(id, trip, gen)
+	return trip, nil
+}
+
+// Invalidate drops the trip so the next read goes to the database. Every path
+// that changes a trip must call it.
+func (c *TripCache) Invalidate(id uuid.UUID) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.group.Forget(id.String())
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *TripCache) put(id uuid.UUID, trip Trip, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+	c.entries[id] = c.order.PushFront(&tripCacheEntry{id, trip, time.Now().Add(c.ttl)})
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *TripCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*tripCacheEntry).id)
+}
+
+// CachedQueries serves GetTrip through a TripCache and invalidates it on the
+// trip writes.
+type CachedQueries struct {
+	*RetryingQueries
+	trips *TripCache
+}
+
+func NewCached(q *RetryingQueries, trips *TripCache) *CachedQueries {
+	return &CachedQueries{q, trips}
+}
+
+func (q *CachedQueries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	return q.trips.Get(ctx, id, q.RetryingQueries.GetTrip)
+}
+
+func (q *CachedQueries) PublishTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (bool, error) {
+	defer q.trips.Invalidate(tripID)
+	return q.RetryingQueries.PublishTrip(ctx, pool, tripID)
+}
+
+func (q *CachedQueries) UpdateTrip(ctx context.Context, pool *pgxpool.Pool, arg UpdateTripIfVersionParams, notify bool) (int32, error) {
+	defer q.trips.Invalidate(arg.ID)
+	return q.RetryingQueries.UpdateTrip(ctx, pool, arg, notify)
+}
+
+func (q *CachedQueries) VerifyOwnerEmailChange(ctx context.Context, arg VerifyOwnerEmailChangeParams) (int64, error) {
+	defer q.trips.Invalidate(arg.TripID)
+	return q.RetryingQueries.VerifyOwnerEmailChange(ctx, arg)
+}
+
+func (q *CachedQueries) ConfirmTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (bool, error) {
+	defer q.trips.Invalidate(tripID)
+	return q.RetryingQueries.ConfirmTrip(ctx, pool, tripID)
+}
+
+func (q *CachedQueries) SelectTripDateOption(ctx context.Context, pool *pgxpool.Pool, tripID, optionID uuid.UUID) (bool, error) {
+	defer q.trips.Invalidate(tripID)
+	return q.RetryingQueries.SelectTripDateOption(ctx, pool, tripID, optionID)
+}
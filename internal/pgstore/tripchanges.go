@@ -0,0 +1,42 @@
+package pgstore
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TripChange is a trip field an update changed, the payload of the trip
+// updated e-mail. Dates are RFC3339 in UTC.
+type TripChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// TripChanges lists the fields participants are told about, the destination
+// and the dates, that differ between the trip and the update.
+func TripChanges(before Trip, after UpdateTripIfVersionParams) []TripChange {
+	var changes []TripChange
+	if before.Destination != after.Destination {
+		changes = append(changes, TripChange{"destination", before.Destination, after.Destination})
+	}
+	if changed(before.StartsAt, after.StartsAt) {
+		changes = append(changes, TripChange{"starts_at", formatChange(before.StartsAt), formatChange(after.StartsAt)})
+	}
+	if changed(before.EndsAt, after.EndsAt) {
+		changes = append(changes, TripChange{"ends_at", formatChange(before.EndsAt), formatChange(after.EndsAt)})
+	}
+	return changes
+}
+
+func changed(a, b pgtype.Timestamptz) bool {
+	return a.Valid != b.Valid || !a.Time.Equal(b.Time)
+}
+
+func formatChange(ts pgtype.Timestamptz) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.UTC().Format(time.RFC3339)
+}
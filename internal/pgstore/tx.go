@@ -0,0 +1,38 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WithTx runs fn in a transaction on pool, committing it when fn returns nil
+// and rolling it back otherwise.
+//
+// The writes spanning several queries come in two flavours: an XxxTx method
+// doing the work on a transaction the caller owns, and Xxx taking the pool,
+// which runs XxxTx through WithTx. Operations made of several of them, like
+// cloning a trip, call the Tx variants from a single WithTx instead of
+// beginning transactions of their own, and the generated queries join in
+// through q.WithTx(tx). Trip writes made that way bypass CachedQueries, so
+// invalidate the trip once WithTx returns.
+func WithTx(ctx context.Context, pool *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// VersionConflictError is returned by UpdateTrip when the trip changed since
+// the caller read it, Current is the version to read again.
+type VersionConflictError struct {
+	Current int32
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("pgstore: trip version conflict, current version is %d", e.Current)
+}
+
+// UpdateTrip applies the update only if the trip is still at arg.Version and
+// returns the new version. A missing trip is pgx.ErrNoRows.
+//
+// With notify set, a confirmed trip whose dates or destination change queues
+// a trip updated e-mail for every confirmed participant. The row read for the
+// diff is the one the update replaces, since the version check fails on any
+// write in between, and the e-mails commit along with the update.
+func (q *Queries) UpdateTrip(ctx context.Context, pool *pgxpool.Pool, arg UpdateTripIfVersionParams, notify bool) (int32, error) {
+	var version int32
+	err := WithTx(ctx, pool, func(tx pgx.Tx) error {
+		var err error
+		version, err = q.UpdateTripTx(ctx, tx, arg, notify)
+		return err
+	})
+	return version, err
+}
+
+// UpdateTripTx is UpdateTrip on the caller's transaction.
+func (q *Queries) UpdateTripTx(ctx context.Context, tx pgx.Tx, arg UpdateTripIfVersionParams, notify bool) (int32, error) {
+	qtx := q.WithTx(tx)
+
+	before, err := qtx.GetTrip(ctx, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	version, err := qtx.UpdateTripIfVersion(ctx, arg)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return 0, err
+		}
+
+		current, err := qtx.GetTripVersion(ctx, arg.ID)
+		if err != nil {
+			return 0, err
+		}
+		return 0, &VersionConflictError{Current: current}
+	}
+
+	changes := TripChanges(before, arg)
+	if notify && before.IsConfirmed && len(changes) > 0 {
+		payload, err := json.Marshal(changes)
+		if err != nil {
+			return 0, fmt.Errorf("pgstore: failed to encode changes for UpdateTrip: %w", err)
+		}
+
+		if _, err := qtx.EnqueueTripUpdatedEmails(ctx, EnqueueTripUpdatedEmailsParams{
+			Kind:      EmailKindTripUpdated,
+			RequestID: RequestID(ctx),
+			Payload:   payload,
+			TripID:    arg.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("pgstore: failed to enqueue emails for UpdateTrip: %w", err)
+		}
+	}
+
+	return version, nil
+}
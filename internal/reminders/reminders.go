@@ -0,0 +1,59 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"journey/internal/logctx"
+	"journey/internal/pgstore"
+
+	"go.uber.org/zap"
+)
+
+type store interface {
+	QueueDueActivityReminders(ctx context.Context, arg pgstore.QueueDueActivityRemindersParams) (int64, error)
+	CloseDueRSVPs(ctx context.Context, arg pgstore.CloseDueRSVPsParams) (int64, error)
+}
+
+// Scheduler moves due activity reminders into the e-mail outbox, and closes
+// the RSVPs of trips whose deadline passed, expiring the pending invites and
+// queueing the owner headcount. Marking the row and queueing the e-mail
+// happen in one statement, so each is queued exactly once even across
+// restarts or with several replicas.
+type Scheduler struct {
+	store  store
+	logger *zap.Logger
+}
+
+func NewScheduler(store store, logger *zap.Logger) *Scheduler {
+	return &Scheduler{store, logger}
+}
+
+// QueueReminders queues the activity reminders that are due.
+func (s *Scheduler) QueueReminders(ctx context.Context) error {
+	queued, err := s.store.QueueDueActivityReminders(ctx, pgstore.QueueDueActivityRemindersParams{
+		Kind:      pgstore.EmailKindActivityReminder,
+		RequestID: pgstore.RequestID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("reminders: failed to queue activity reminders: %w", err)
+	}
+	if queued > 0 {
+		logctx.From(ctx, s.logger).Info("activity reminders queued", zap.Int64("count", queued))
+	}
+	return nil
+}
+
+// CloseRSVPs closes the RSVPs of the trips whose deadline passed.
+func (s *Scheduler) CloseRSVPs(ctx context.Context) error {
+	closed, err := s.store.CloseDueRSVPs(ctx, pgstore.CloseDueRSVPsParams{
+		Kind:      pgstore.EmailKindRSVPHeadcount,
+		RequestID: pgstore.RequestID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("reminders: failed to close due RSVPs: %w", err)
+	}
+	if closed > 0 {
+		logctx.From(ctx, s.logger).Info("trip RSVPs closed", zap.Int64("count", closed))
+	}
+	return nil
+}